@@ -51,7 +51,7 @@ func (c *pulsarClient) BrokerStats() BrokerStats {
 func (bs *brokerStats) GetMetrics() ([]Metrics, error) {
 	endpoint := bs.pulsar.endpoint(bs.apiVersion, bs.basePath, "/metrics")
 	var response []Metrics
-	err := bs.pulsar.restClient.Get(endpoint, &response)
+	err := bs.pulsar.restClient.WithOperation("BrokerStats.GetMetrics").Get(endpoint, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -62,7 +62,7 @@ func (bs *brokerStats) GetMetrics() ([]Metrics, error) {
 func (bs *brokerStats) GetMBeans() ([]Metrics, error) {
 	endpoint := bs.pulsar.endpoint(bs.apiVersion, bs.basePath, "/mbeans")
 	var response []Metrics
-	err := bs.pulsar.restClient.Get(endpoint, &response)
+	err := bs.pulsar.restClient.WithOperation("BrokerStats.GetMBeans").Get(endpoint, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -72,7 +72,7 @@ func (bs *brokerStats) GetMBeans() ([]Metrics, error) {
 
 func (bs *brokerStats) GetTopics() (string, error) {
 	endpoint := bs.pulsar.endpoint(bs.apiVersion, bs.basePath, "/topics")
-	buf, err := bs.pulsar.restClient.GetWithQueryParams(endpoint, nil, nil, false)
+	buf, err := bs.pulsar.restClient.WithOperation("BrokerStats.GetTopics").GetWithQueryParams(endpoint, nil, nil, false)
 	if err != nil {
 		return "", err
 	}
@@ -83,7 +83,7 @@ func (bs *brokerStats) GetTopics() (string, error) {
 func (bs *brokerStats) GetLoadReport() (*LocalBrokerData, error) {
 	endpoint := bs.pulsar.endpoint(bs.apiVersion, bs.basePath, "/load-report")
 	response := NewLocalBrokerData()
-	err := bs.pulsar.restClient.Get(endpoint, &response)
+	err := bs.pulsar.restClient.WithOperation("BrokerStats.GetLoadReport").Get(endpoint, &response)
 	if err != nil {
 		return nil, nil
 	}
@@ -93,7 +93,7 @@ func (bs *brokerStats) GetLoadReport() (*LocalBrokerData, error) {
 func (bs *brokerStats) GetAllocatorStats(allocatorName string) (*AllocatorStats, error) {
 	endpoint := bs.pulsar.endpoint(bs.apiVersion, bs.basePath, "/allocator-stats", allocatorName)
 	var allocatorStats AllocatorStats
-	err := bs.pulsar.restClient.Get(endpoint, &allocatorStats)
+	err := bs.pulsar.restClient.WithOperation("BrokerStats.GetAllocatorStats").Get(endpoint, &allocatorStats)
 	if err != nil {
 		return nil, err
 	}
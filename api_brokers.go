@@ -71,7 +71,7 @@ func (c *pulsarClient) Brokers() Brokers {
 func (b *broker) GetActiveBrokers(cluster string) ([]string, error) {
 	endpoint := b.pulsar.endpoint(b.apiVersion, b.basePath, cluster)
 	var res []string
-	err := b.pulsar.restClient.Get(endpoint, &res)
+	err := b.pulsar.restClient.WithOperation("Brokers.GetActiveBrokers").Get(endpoint, &res)
 	if err != nil {
 		return nil, err
 	}
@@ -81,7 +81,7 @@ func (b *broker) GetActiveBrokers(cluster string) ([]string, error) {
 func (b *broker) GetDynamicConfigurationNames() ([]string, error) {
 	endpoint := b.pulsar.endpoint(b.apiVersion, b.basePath, "/configuration/")
 	var res []string
-	err := b.pulsar.restClient.Get(endpoint, &res)
+	err := b.pulsar.restClient.WithOperation("Brokers.GetDynamicConfigurationNames").Get(endpoint, &res)
 	if err != nil {
 		return nil, err
 	}
@@ -91,7 +91,7 @@ func (b *broker) GetDynamicConfigurationNames() ([]string, error) {
 func (b *broker) GetOwnedNamespaces(cluster, brokerURL string) (map[string]NamespaceOwnershipStatus, error) {
 	endpoint := b.pulsar.endpoint(b.apiVersion, b.basePath, cluster, brokerURL, "ownedNamespaces")
 	var res map[string]NamespaceOwnershipStatus
-	err := b.pulsar.restClient.Get(endpoint, &res)
+	err := b.pulsar.restClient.WithOperation("Brokers.GetOwnedNamespaces").Get(endpoint, &res)
 	if err != nil {
 		return nil, err
 	}
@@ -101,18 +101,18 @@ func (b *broker) GetOwnedNamespaces(cluster, brokerURL string) (map[string]Names
 func (b *broker) UpdateDynamicConfiguration(configName, configValue string) error {
 	value := url.QueryEscape(configValue)
 	endpoint := b.pulsar.endpoint(b.apiVersion, b.basePath, "/configuration/", configName, value)
-	return b.pulsar.restClient.Post(endpoint, nil)
+	return b.pulsar.restClient.WithOperation("Brokers.UpdateDynamicConfiguration").Post(endpoint, nil)
 }
 
 func (b *broker) DeleteDynamicConfiguration(configName string) error {
 	endpoint := b.pulsar.endpoint(b.apiVersion, b.basePath, "/configuration/", configName)
-	return b.pulsar.restClient.Delete(endpoint)
+	return b.pulsar.restClient.WithOperation("Brokers.DeleteDynamicConfiguration").Delete(endpoint)
 }
 
 func (b *broker) GetRuntimeConfigurations() (map[string]string, error) {
 	endpoint := b.pulsar.endpoint(b.apiVersion, b.basePath, "/configuration/", "runtime")
 	var res map[string]string
-	err := b.pulsar.restClient.Get(endpoint, &res)
+	err := b.pulsar.restClient.WithOperation("Brokers.GetRuntimeConfigurations").Get(endpoint, &res)
 	if err != nil {
 		return nil, err
 	}
@@ -122,7 +122,7 @@ func (b *broker) GetRuntimeConfigurations() (map[string]string, error) {
 func (b *broker) GetInternalConfigurationData() (*InternalConfigurationData, error) {
 	endpoint := b.pulsar.endpoint(b.apiVersion, b.basePath, "/internal-configuration")
 	var res InternalConfigurationData
-	err := b.pulsar.restClient.Get(endpoint, &res)
+	err := b.pulsar.restClient.WithOperation("Brokers.GetInternalConfigurationData").Get(endpoint, &res)
 	if err != nil {
 		return nil, err
 	}
@@ -132,7 +132,7 @@ func (b *broker) GetInternalConfigurationData() (*InternalConfigurationData, err
 func (b *broker) GetAllDynamicConfigurations() (map[string]string, error) {
 	endpoint := b.pulsar.endpoint(b.apiVersion, b.basePath, "/configuration/", "values")
 	var res map[string]string
-	err := b.pulsar.restClient.Get(endpoint, &res)
+	err := b.pulsar.restClient.WithOperation("Brokers.GetAllDynamicConfigurations").Get(endpoint, &res)
 	if err != nil {
 		return nil, err
 	}
@@ -142,11 +142,15 @@ func (b *broker) GetAllDynamicConfigurations() (map[string]string, error) {
 func (b *broker) HealthCheck() error {
 	endpoint := b.pulsar.endpoint(b.apiVersion, b.basePath, "/health")
 
-	buf, err := b.pulsar.restClient.GetWithQueryParams(endpoint, nil, nil, false)
+	buf, err := b.pulsar.restClient.WithOperation("Brokers.HealthCheck").GetWithQueryParams(endpoint, nil, nil, false)
 	if err != nil {
 		return err
 	}
+	return checkHealth(buf)
+}
 
+// checkHealth checks the result of a broker health check.
+func checkHealth(buf []byte) error {
 	if !strings.EqualFold(string(buf), "ok") {
 		return fmt.Errorf("health check returned unexpected result: %s", string(buf))
 	}
@@ -71,69 +71,69 @@ func (c *pulsarClient) Clusters() Clusters {
 
 func (c *clusters) List() ([]string, error) {
 	var clusters []string
-	err := c.pulsar.restClient.Get(c.pulsar.endpoint(c.apiVersion, c.basePath), &clusters)
+	err := c.pulsar.restClient.WithOperation("Clusters.List").Get(c.pulsar.endpoint(c.apiVersion, c.basePath), &clusters)
 	return clusters, err
 }
 
 func (c *clusters) Get(name string) (ClusterData, error) {
 	cdata := ClusterData{}
 	endpoint := c.pulsar.endpoint(c.apiVersion, c.basePath, name)
-	err := c.pulsar.restClient.Get(endpoint, &cdata)
+	err := c.pulsar.restClient.WithOperation("Clusters.Get").Get(endpoint, &cdata)
 	return cdata, err
 }
 
 func (c *clusters) Create(cdata ClusterData) error {
 	endpoint := c.pulsar.endpoint(c.apiVersion, c.basePath, cdata.Name)
-	return c.pulsar.restClient.Put(endpoint, &cdata)
+	return c.pulsar.restClient.WithOperation("Clusters.Create").Put(endpoint, &cdata)
 }
 
 func (c *clusters) Delete(name string) error {
 	endpoint := c.pulsar.endpoint(c.apiVersion, c.basePath, name)
-	return c.pulsar.restClient.Delete(endpoint)
+	return c.pulsar.restClient.WithOperation("Clusters.Delete").Delete(endpoint)
 }
 
 func (c *clusters) Update(cdata ClusterData) error {
 	endpoint := c.pulsar.endpoint(c.apiVersion, c.basePath, cdata.Name)
-	return c.pulsar.restClient.Post(endpoint, &cdata)
+	return c.pulsar.restClient.WithOperation("Clusters.Update").Post(endpoint, &cdata)
 }
 
 func (c *clusters) GetPeerClusters(name string) ([]string, error) {
 	var peerClusters []string
 	endpoint := c.pulsar.endpoint(c.apiVersion, c.basePath, name, "peers")
-	err := c.pulsar.restClient.Get(endpoint, &peerClusters)
+	err := c.pulsar.restClient.WithOperation("Clusters.GetPeerClusters").Get(endpoint, &peerClusters)
 	return peerClusters, err
 }
 
 func (c *clusters) UpdatePeerClusters(cluster string, peerClusters []string) error {
 	endpoint := c.pulsar.endpoint(c.apiVersion, c.basePath, cluster, "peers")
-	return c.pulsar.restClient.Post(endpoint, peerClusters)
+	return c.pulsar.restClient.WithOperation("Clusters.UpdatePeerClusters").Post(endpoint, peerClusters)
 }
 
 func (c *clusters) CreateFailureDomain(data FailureDomainData) error {
 	endpoint := c.pulsar.endpoint(c.apiVersion, c.basePath, data.ClusterName, "failureDomains", data.DomainName)
-	return c.pulsar.restClient.Post(endpoint, &data)
+	return c.pulsar.restClient.WithOperation("Clusters.CreateFailureDomain").Post(endpoint, &data)
 }
 
 func (c *clusters) GetFailureDomain(clusterName string, domainName string) (FailureDomainData, error) {
 	var res FailureDomainData
 	endpoint := c.pulsar.endpoint(c.apiVersion, c.basePath, clusterName, "failureDomains", domainName)
-	err := c.pulsar.restClient.Get(endpoint, &res)
+	err := c.pulsar.restClient.WithOperation("Clusters.GetFailureDomain").Get(endpoint, &res)
 	return res, err
 }
 
 func (c *clusters) ListFailureDomains(clusterName string) (FailureDomainMap, error) {
 	var domainData FailureDomainMap
 	endpoint := c.pulsar.endpoint(c.apiVersion, c.basePath, clusterName, "failureDomains")
-	err := c.pulsar.restClient.Get(endpoint, &domainData)
+	err := c.pulsar.restClient.WithOperation("Clusters.ListFailureDomains").Get(endpoint, &domainData)
 	return domainData, err
 }
 
 func (c *clusters) DeleteFailureDomain(data FailureDomainData) error {
 	endpoint := c.pulsar.endpoint(c.apiVersion, c.basePath, data.ClusterName, "failureDomains", data.DomainName)
-	return c.pulsar.restClient.Delete(endpoint)
+	return c.pulsar.restClient.WithOperation("Clusters.DeleteFailureDomain").Delete(endpoint)
 }
 
 func (c *clusters) UpdateFailureDomain(data FailureDomainData) error {
 	endpoint := c.pulsar.endpoint(c.apiVersion, c.basePath, data.ClusterName, "failureDomains", data.DomainName)
-	return c.pulsar.restClient.Post(endpoint, &data)
+	return c.pulsar.restClient.WithOperation("Clusters.UpdateFailureDomain").Post(endpoint, &data)
 }
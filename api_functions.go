@@ -18,13 +18,15 @@ package pulsaradmin
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"mime/multipart"
-	"net/textproto"
 	"os"
 	"path/filepath"
 	"strings"
+
+	"github.com/streamnative/pulsar-admin-go/internal/rest"
 )
 
 // Functions is admin interface for functions management
@@ -32,6 +34,10 @@ type Functions interface {
 	// CreateFunc create a new function.
 	CreateFunc(data *FunctionConfig, fileName string) error
 
+	// CreateFuncWithReader create a new function with the code read from r, streamed as it is read and
+	// uploaded as fileName.
+	CreateFuncWithReader(data *FunctionConfig, fileName string, r io.Reader, options *UploadOptions) error
+
 	// CreateFuncWithURL create a new function by providing url from which fun-pkg can be downloaded.
 	// supported url: http/file
 	// eg:
@@ -117,6 +123,11 @@ type Functions interface {
 	// UpdateFunction updates the configuration for a function.
 	UpdateFunction(functionConfig *FunctionConfig, fileName string, updateOptions *UpdateOptions) error
 
+	// UpdateFunctionWithReader updates the configuration for a function, with the code read from r,
+	// streamed as it is read and uploaded as fileName.
+	UpdateFunctionWithReader(functionConfig *FunctionConfig, fileName string, r io.Reader,
+		updateOptions *UpdateOptions, options *UploadOptions) error
+
 	// UpdateFunctionWithURL updates the configuration for a function.
 	//
 	// Update a function by providing url from which fun-pkg can be downloaded. supported url: http/file
@@ -144,76 +155,44 @@ func (c *pulsarClient) Functions() Functions {
 	}
 }
 
-func (f *functions) createStringFromField(w *multipart.Writer, value string) (io.Writer, error) {
-	h := make(textproto.MIMEHeader)
-	h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s" `, value))
-	h.Set("Content-Type", "application/json")
-	return w.CreatePart(h)
+func (f *functions) CreateFunc(funcConf *FunctionConfig, fileName string) error {
+	if strings.HasPrefix(fileName, "builtin://") {
+		// If the function code is built in, we don't need to submit here
+		fileName = ""
+	}
+	return uploadFile(fileName, func(name string, r io.Reader) error {
+		return f.createFunc(f.pulsar.restClient.WithOperation("Functions.CreateFunc"), funcConf, name, r, nil)
+	})
 }
 
-func (f *functions) createTextFromFiled(w *multipart.Writer, value string) (io.Writer, error) {
-	h := make(textproto.MIMEHeader)
-	h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s" `, value))
-	h.Set("Content-Type", "text/plain")
-	return w.CreatePart(h)
+func (f *functions) CreateFuncWithReader(funcConf *FunctionConfig, fileName string, r io.Reader,
+	options *UploadOptions,
+) error {
+	if r == nil {
+		return errors.New("reader is nil")
+	}
+	client := f.pulsar.restClient.WithOperation("Functions.CreateFuncWithReader")
+	return f.createFunc(client, funcConf, fileName, r, options)
 }
 
-func (f *functions) CreateFunc(funcConf *FunctionConfig, fileName string) error {
+func (f *functions) createFunc(client *rest.Client, funcConf *FunctionConfig, fileName string, r io.Reader,
+	options *UploadOptions,
+) error {
 	endpoint := f.pulsar.endpoint(f.apiVersion, f.basePath, funcConf.Tenant, funcConf.Namespace, funcConf.Name)
 
-	// buffer to store our request as bytes
-	bodyBuf := bytes.NewBufferString("")
-
-	multiPartWriter := multipart.NewWriter(bodyBuf)
-
 	jsonData, err := json.Marshal(funcConf)
 	if err != nil {
 		return err
 	}
 
-	stringWriter, err := f.createStringFromField(multiPartWriter, "functionConfig")
-	if err != nil {
-		return err
-	}
-
-	_, err = stringWriter.Write(jsonData)
-	if err != nil {
-		return err
-	}
-
-	if fileName != "" && !strings.HasPrefix(fileName, "builtin://") {
-		// If the function code is built in, we don't need to submit here
-		file, err := os.Open(fileName)
-		if err != nil {
-			return err
-		}
-		defer file.Close()
-
-		part, err := multiPartWriter.CreateFormFile("data", filepath.Base(file.Name()))
-		if err != nil {
-			return err
-		}
-
-		// copy the actual file content to the filed's writer
-		_, err = io.Copy(part, file)
-		if err != nil {
+	upload := newMultipartUpload(options)
+	upload.addJSONField("functionConfig", jsonData)
+	if r != nil {
+		if err := upload.setFile("data", filepath.Base(fileName), r); err != nil {
 			return err
 		}
 	}
-
-	// In here, we completed adding the file and the fields, let's close the multipart writer
-	// So it writes the ending boundary
-	if err = multiPartWriter.Close(); err != nil {
-		return err
-	}
-
-	contentType := multiPartWriter.FormDataContentType()
-	err = f.pulsar.restClient.PostWithMultiPart(endpoint, nil, bodyBuf, contentType)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return upload.post(client, endpoint)
 }
 
 func (f *functions) CreateFuncWithURL(funcConf *FunctionConfig, pkgURL string) error {
@@ -223,7 +202,7 @@ func (f *functions) CreateFuncWithURL(funcConf *FunctionConfig, pkgURL string) e
 
 	multiPartWriter := multipart.NewWriter(bodyBuf)
 
-	textWriter, err := f.createTextFromFiled(multiPartWriter, "url")
+	textWriter, err := createFieldPart(multiPartWriter, "url", "text/plain")
 	if err != nil {
 		return err
 	}
@@ -238,7 +217,7 @@ func (f *functions) CreateFuncWithURL(funcConf *FunctionConfig, pkgURL string) e
 		return err
 	}
 
-	stringWriter, err := f.createStringFromField(multiPartWriter, "functionConfig")
+	stringWriter, err := createFieldPart(multiPartWriter, "functionConfig", "application/json")
 	if err != nil {
 		return err
 	}
@@ -253,7 +232,8 @@ func (f *functions) CreateFuncWithURL(funcConf *FunctionConfig, pkgURL string) e
 	}
 
 	contentType := multiPartWriter.FormDataContentType()
-	err = f.pulsar.restClient.PostWithMultiPart(endpoint, nil, bodyBuf, contentType)
+	client := f.pulsar.restClient.WithOperation("Functions.CreateFuncWithURL")
+	err = client.PostWithMultiPart(endpoint, nil, bodyBuf, contentType)
 	if err != nil {
 		return err
 	}
@@ -263,19 +243,19 @@ func (f *functions) CreateFuncWithURL(funcConf *FunctionConfig, pkgURL string) e
 
 func (f *functions) StopFunction(tenant, namespace, name string) error {
 	endpoint := f.pulsar.endpoint(f.apiVersion, f.basePath, tenant, namespace, name)
-	return f.pulsar.restClient.Post(endpoint+"/stop", nil)
+	return f.pulsar.restClient.WithOperation("Functions.StopFunction").Post(endpoint+"/stop", nil)
 }
 
 func (f *functions) StopFunctionWithID(tenant, namespace, name string, instanceID int) error {
 	id := fmt.Sprintf("%d", instanceID)
 	endpoint := f.pulsar.endpoint(f.apiVersion, f.basePath, tenant, namespace, name, id)
 
-	return f.pulsar.restClient.Post(endpoint+"/stop", nil)
+	return f.pulsar.restClient.WithOperation("Functions.StopFunctionWithID").Post(endpoint+"/stop", nil)
 }
 
 func (f *functions) DeleteFunction(tenant, namespace, name string) error {
 	endpoint := f.pulsar.endpoint(f.apiVersion, f.basePath, tenant, namespace, name)
-	return f.pulsar.restClient.Delete(endpoint)
+	return f.pulsar.restClient.WithOperation("Functions.DeleteFunction").Delete(endpoint)
 }
 
 func (f *functions) DownloadFunction(path, destinationFile string) error {
@@ -295,7 +275,8 @@ func (f *functions) DownloadFunction(path, destinationFile string) error {
 	tmpMap := make(map[string]string)
 	tmpMap["path"] = path
 
-	_, err = f.pulsar.restClient.GetWithOptions(endpoint, nil, tmpMap, false, file)
+	client := f.pulsar.restClient.WithOperation("Functions.DownloadFunction")
+	_, err = client.GetWithOptions(endpoint, nil, tmpMap, false, file)
 	if err != nil {
 		return err
 	}
@@ -316,7 +297,8 @@ func (f *functions) DownloadFunctionByNs(destinationFile, tenant, namespace, fun
 		return err
 	}
 
-	_, err = f.pulsar.restClient.GetWithOptions(endpoint, nil, nil, false, file)
+	client := f.pulsar.restClient.WithOperation("Functions.DownloadFunctionByNs")
+	_, err = client.GetWithOptions(endpoint, nil, nil, false, file)
 	if err != nil {
 		return err
 	}
@@ -326,117 +308,91 @@ func (f *functions) DownloadFunctionByNs(destinationFile, tenant, namespace, fun
 
 func (f *functions) StartFunction(tenant, namespace, name string) error {
 	endpoint := f.pulsar.endpoint(f.apiVersion, f.basePath, tenant, namespace, name)
-	return f.pulsar.restClient.Post(endpoint+"/start", nil)
+	return f.pulsar.restClient.WithOperation("Functions.StartFunction").Post(endpoint+"/start", nil)
 }
 
 func (f *functions) StartFunctionWithID(tenant, namespace, name string, instanceID int) error {
 	id := fmt.Sprintf("%d", instanceID)
 	endpoint := f.pulsar.endpoint(f.apiVersion, f.basePath, tenant, namespace, name, id)
 
-	return f.pulsar.restClient.Post(endpoint+"/start", nil)
+	return f.pulsar.restClient.WithOperation("Functions.StartFunctionWithID").Post(endpoint+"/start", nil)
 }
 
 func (f *functions) RestartFunction(tenant, namespace, name string) error {
 	endpoint := f.pulsar.endpoint(f.apiVersion, f.basePath, tenant, namespace, name)
-	return f.pulsar.restClient.Post(endpoint+"/restart", nil)
+	return f.pulsar.restClient.WithOperation("Functions.RestartFunction").Post(endpoint+"/restart", nil)
 }
 
 func (f *functions) RestartFunctionWithID(tenant, namespace, name string, instanceID int) error {
 	id := fmt.Sprintf("%d", instanceID)
 	endpoint := f.pulsar.endpoint(f.apiVersion, f.basePath, tenant, namespace, name, id)
 
-	return f.pulsar.restClient.Post(endpoint+"/restart", nil)
+	return f.pulsar.restClient.WithOperation("Functions.RestartFunctionWithID").Post(endpoint+"/restart", nil)
 }
 
 func (f *functions) GetFunctions(tenant, namespace string) ([]string, error) {
 	var functions []string
 	endpoint := f.pulsar.endpoint(f.apiVersion, f.basePath, tenant, namespace)
-	err := f.pulsar.restClient.Get(endpoint, &functions)
+	err := f.pulsar.restClient.WithOperation("Functions.GetFunctions").Get(endpoint, &functions)
 	return functions, err
 }
 
 func (f *functions) GetFunction(tenant, namespace, name string) (FunctionConfig, error) {
 	var functionConfig FunctionConfig
 	endpoint := f.pulsar.endpoint(f.apiVersion, f.basePath, tenant, namespace, name)
-	err := f.pulsar.restClient.Get(endpoint, &functionConfig)
+	err := f.pulsar.restClient.WithOperation("Functions.GetFunction").Get(endpoint, &functionConfig)
 	return functionConfig, err
 }
 
 func (f *functions) UpdateFunction(functionConfig *FunctionConfig, fileName string,
 	updateOptions *UpdateOptions,
 ) error {
-	endpoint := f.pulsar.endpoint(f.apiVersion, f.basePath, functionConfig.Tenant, functionConfig.Namespace,
-		functionConfig.Name)
-	// buffer to store our request as bytes
-	bodyBuf := bytes.NewBufferString("")
-
-	multiPartWriter := multipart.NewWriter(bodyBuf)
-
-	jsonData, err := json.Marshal(functionConfig)
-	if err != nil {
-		return err
+	if strings.HasPrefix(fileName, "builtin://") {
+		// If the function code is built in, we don't need to submit here
+		fileName = ""
 	}
+	return uploadFile(fileName, func(name string, r io.Reader) error {
+		client := f.pulsar.restClient.WithOperation("Functions.UpdateFunction")
+		return f.updateFunction(client, functionConfig, name, r, updateOptions, nil)
+	})
+}
 
-	stringWriter, err := f.createStringFromField(multiPartWriter, "functionConfig")
-	if err != nil {
-		return err
+func (f *functions) UpdateFunctionWithReader(functionConfig *FunctionConfig, fileName string, r io.Reader,
+	updateOptions *UpdateOptions, options *UploadOptions,
+) error {
+	if r == nil {
+		return errors.New("reader is nil")
 	}
+	client := f.pulsar.restClient.WithOperation("Functions.UpdateFunctionWithReader")
+	return f.updateFunction(client, functionConfig, fileName, r, updateOptions, options)
+}
 
-	_, err = stringWriter.Write(jsonData)
+func (f *functions) updateFunction(client *rest.Client, functionConfig *FunctionConfig, fileName string, r io.Reader,
+	updateOptions *UpdateOptions, options *UploadOptions,
+) error {
+	endpoint := f.pulsar.endpoint(f.apiVersion, f.basePath, functionConfig.Tenant, functionConfig.Namespace,
+		functionConfig.Name)
+
+	jsonData, err := json.Marshal(functionConfig)
 	if err != nil {
 		return err
 	}
 
+	upload := newMultipartUpload(options)
+	upload.addJSONField("functionConfig", jsonData)
 	if updateOptions != nil {
 		updateData, err := json.Marshal(updateOptions)
 		if err != nil {
 			return err
 		}
-
-		updateStrWriter, err := f.createStringFromField(multiPartWriter, "updateOptions")
-		if err != nil {
-			return err
-		}
-
-		_, err = updateStrWriter.Write(updateData)
-		if err != nil {
-			return err
-		}
+		upload.addJSONField("updateOptions", updateData)
 	}
-
-	if fileName != "" && !strings.HasPrefix(fileName, "builtin://") {
-		// If the function code is built in, we don't need to submit here
-		file, err := os.Open(fileName)
-		if err != nil {
-			return err
-		}
-		defer file.Close()
-
-		part, err := multiPartWriter.CreateFormFile("data", filepath.Base(file.Name()))
-		if err != nil {
-			return err
-		}
-
-		// copy the actual file content to the filed's writer
-		_, err = io.Copy(part, file)
-		if err != nil {
+	if r != nil {
+		if err := upload.setFile("data", filepath.Base(fileName), r); err != nil {
 			return err
 		}
 	}
-
-	// In here, we completed adding the file and the fields, let's close the multipart writer
-	// So it writes the ending boundary
-	if err = multiPartWriter.Close(); err != nil {
-		return err
-	}
-
-	contentType := multiPartWriter.FormDataContentType()
-	err = f.pulsar.restClient.PutWithMultiPart(endpoint, bodyBuf, contentType)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return upload.put(client, endpoint)
 }
 
 func (f *functions) UpdateFunctionWithURL(functionConfig *FunctionConfig, pkgURL string,
@@ -449,7 +405,7 @@ func (f *functions) UpdateFunctionWithURL(functionConfig *FunctionConfig, pkgURL
 
 	multiPartWriter := multipart.NewWriter(bodyBuf)
 
-	textWriter, err := f.createTextFromFiled(multiPartWriter, "url")
+	textWriter, err := createFieldPart(multiPartWriter, "url", "text/plain")
 	if err != nil {
 		return err
 	}
@@ -464,7 +420,7 @@ func (f *functions) UpdateFunctionWithURL(functionConfig *FunctionConfig, pkgURL
 		return err
 	}
 
-	stringWriter, err := f.createStringFromField(multiPartWriter, "functionConfig")
+	stringWriter, err := createFieldPart(multiPartWriter, "functionConfig", "application/json")
 	if err != nil {
 		return err
 	}
@@ -480,7 +436,7 @@ func (f *functions) UpdateFunctionWithURL(functionConfig *FunctionConfig, pkgURL
 			return err
 		}
 
-		updateStrWriter, err := f.createStringFromField(multiPartWriter, "updateOptions")
+		updateStrWriter, err := createFieldPart(multiPartWriter, "updateOptions", "application/json")
 		if err != nil {
 			return err
 		}
@@ -498,7 +454,8 @@ func (f *functions) UpdateFunctionWithURL(functionConfig *FunctionConfig, pkgURL
 	}
 
 	contentType := multiPartWriter.FormDataContentType()
-	err = f.pulsar.restClient.PutWithMultiPart(endpoint, bodyBuf, contentType)
+	client := f.pulsar.restClient.WithOperation("Functions.UpdateFunctionWithURL")
+	err = client.PutWithMultiPart(endpoint, bodyBuf, contentType)
 	if err != nil {
 		return err
 	}
@@ -509,7 +466,7 @@ func (f *functions) UpdateFunctionWithURL(functionConfig *FunctionConfig, pkgURL
 func (f *functions) GetFunctionStatus(tenant, namespace, name string) (FunctionStatus, error) {
 	var functionStatus FunctionStatus
 	endpoint := f.pulsar.endpoint(f.apiVersion, f.basePath, tenant, namespace, name)
-	err := f.pulsar.restClient.Get(endpoint+"/status", &functionStatus)
+	err := f.pulsar.restClient.WithOperation("Functions.GetFunctionStatus").Get(endpoint+"/status", &functionStatus)
 	return functionStatus, err
 }
 
@@ -519,14 +476,15 @@ func (f *functions) GetFunctionStatusWithInstanceID(tenant, namespace, name stri
 	var functionInstanceStatusData FunctionInstanceStatusData
 	id := fmt.Sprintf("%d", instanceID)
 	endpoint := f.pulsar.endpoint(f.apiVersion, f.basePath, tenant, namespace, name, id)
-	err := f.pulsar.restClient.Get(endpoint+"/status", &functionInstanceStatusData)
+	client := f.pulsar.restClient.WithOperation("Functions.GetFunctionStatusWithInstanceID")
+	err := client.Get(endpoint+"/status", &functionInstanceStatusData)
 	return functionInstanceStatusData, err
 }
 
 func (f *functions) GetFunctionStats(tenant, namespace, name string) (FunctionStats, error) {
 	var functionStats FunctionStats
 	endpoint := f.pulsar.endpoint(f.apiVersion, f.basePath, tenant, namespace, name)
-	err := f.pulsar.restClient.Get(endpoint+"/stats", &functionStats)
+	err := f.pulsar.restClient.WithOperation("Functions.GetFunctionStats").Get(endpoint+"/stats", &functionStats)
 	return functionStats, err
 }
 
@@ -536,14 +494,15 @@ func (f *functions) GetFunctionStatsWithInstanceID(tenant, namespace, name strin
 	var functionInstanceStatsData FunctionInstanceStatsData
 	id := fmt.Sprintf("%d", instanceID)
 	endpoint := f.pulsar.endpoint(f.apiVersion, f.basePath, tenant, namespace, name, id)
-	err := f.pulsar.restClient.Get(endpoint+"/stats", &functionInstanceStatsData)
+	client := f.pulsar.restClient.WithOperation("Functions.GetFunctionStatsWithInstanceID")
+	err := client.Get(endpoint+"/stats", &functionInstanceStatsData)
 	return functionInstanceStatsData, err
 }
 
 func (f *functions) GetFunctionState(tenant, namespace, name, key string) (FunctionState, error) {
 	var functionState FunctionState
 	endpoint := f.pulsar.endpoint(f.apiVersion, f.basePath, tenant, namespace, name, "state", key)
-	err := f.pulsar.restClient.Get(endpoint, &functionState)
+	err := f.pulsar.restClient.WithOperation("Functions.GetFunctionState").Get(endpoint, &functionState)
 	return functionState, err
 }
 
@@ -560,7 +519,7 @@ func (f *functions) PutFunctionState(tenant, namespace, name string, state Funct
 		return err
 	}
 
-	stateWriter, err := f.createStringFromField(multiPartWriter, "state")
+	stateWriter, err := createFieldPart(multiPartWriter, "state", "application/json")
 	if err != nil {
 		return err
 	}
@@ -579,7 +538,8 @@ func (f *functions) PutFunctionState(tenant, namespace, name string, state Funct
 
 	contentType := multiPartWriter.FormDataContentType()
 
-	err = f.pulsar.restClient.PostWithMultiPart(endpoint, nil, bodyBuf, contentType)
+	client := f.pulsar.restClient.WithOperation("Functions.PutFunctionState")
+	err = client.PostWithMultiPart(endpoint, nil, bodyBuf, contentType)
 
 	if err != nil {
 		return err
@@ -616,7 +576,7 @@ func (f *functions) TriggerFunction(tenant, namespace, name, topic, triggerValue
 	}
 
 	if triggerValue != "" {
-		valueWriter, err := f.createTextFromFiled(multiPartWriter, "data")
+		valueWriter, err := createFieldPart(multiPartWriter, "data", "text/plain")
 		if err != nil {
 			return "", err
 		}
@@ -628,7 +588,7 @@ func (f *functions) TriggerFunction(tenant, namespace, name, topic, triggerValue
 	}
 
 	if topic != "" {
-		topicWriter, err := f.createTextFromFiled(multiPartWriter, "topic")
+		topicWriter, err := createFieldPart(multiPartWriter, "topic", "text/plain")
 		if err != nil {
 			return "", err
 		}
@@ -647,7 +607,8 @@ func (f *functions) TriggerFunction(tenant, namespace, name, topic, triggerValue
 
 	contentType := multiPartWriter.FormDataContentType()
 	var str string
-	err := f.pulsar.restClient.PostWithMultiPart(endpoint, &str, bodyBuf, contentType)
+	client := f.pulsar.restClient.WithOperation("Functions.TriggerFunction")
+	err := client.PostWithMultiPart(endpoint, &str, bodyBuf, contentType)
 	if err != nil {
 		return "", err
 	}
@@ -665,22 +626,11 @@ func (f *functions) Upload(sourceFile, path string) error {
 	}
 	defer file.Close()
 	endpoint := f.pulsar.endpoint(f.apiVersion, f.basePath, "upload")
-	var b bytes.Buffer
-	w := multipart.NewWriter(&b)
-	writer, err := w.CreateFormFile("data", file.Name())
-	if err != nil {
-		return err
-	}
-	_, err = io.Copy(writer, file)
-	if err != nil {
-		return err
-	}
-	if err := w.WriteField("path", path); err != nil {
-		return err
-	}
-	err = w.Close()
-	if err != nil {
+
+	upload := newMultipartUpload(nil)
+	if err := upload.setFile("data", file.Name(), file); err != nil {
 		return err
 	}
-	return f.pulsar.restClient.PostWithMultiPart(endpoint, nil, &b, w.FormDataContentType())
+	upload.addTextField("path", path)
+	return upload.post(f.pulsar.restClient.WithOperation("Functions.Upload"), endpoint)
 }
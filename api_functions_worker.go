@@ -51,7 +51,7 @@ func (c *pulsarClient) FunctionsWorker() FunctionsWorker {
 func (w *worker) GetFunctionsStats() ([]*WorkerFunctionInstanceStats, error) {
 	endpoint := w.pulsar.endpoint(w.apiVersion, w.workerStatsPath, "functionsmetrics")
 	var workerStats []*WorkerFunctionInstanceStats
-	err := w.pulsar.restClient.Get(endpoint, &workerStats)
+	err := w.pulsar.restClient.WithOperation("FunctionsWorker.GetFunctionsStats").Get(endpoint, &workerStats)
 	if err != nil {
 		return nil, err
 	}
@@ -61,7 +61,7 @@ func (w *worker) GetFunctionsStats() ([]*WorkerFunctionInstanceStats, error) {
 func (w *worker) GetMetrics() ([]*Metrics, error) {
 	endpoint := w.pulsar.endpoint(w.apiVersion, w.workerStatsPath, "metrics")
 	var metrics []*Metrics
-	err := w.pulsar.restClient.Get(endpoint, &metrics)
+	err := w.pulsar.restClient.WithOperation("FunctionsWorker.GetMetrics").Get(endpoint, &metrics)
 	if err != nil {
 		return nil, err
 	}
@@ -71,7 +71,7 @@ func (w *worker) GetMetrics() ([]*Metrics, error) {
 func (w *worker) GetCluster() ([]*WorkerInfo, error) {
 	endpoint := w.pulsar.endpoint(w.apiVersion, w.workerPath, "cluster")
 	var workersInfo []*WorkerInfo
-	err := w.pulsar.restClient.Get(endpoint, &workersInfo)
+	err := w.pulsar.restClient.WithOperation("FunctionsWorker.GetCluster").Get(endpoint, &workersInfo)
 	if err != nil {
 		return nil, err
 	}
@@ -81,7 +81,7 @@ func (w *worker) GetCluster() ([]*WorkerInfo, error) {
 func (w *worker) GetClusterLeader() (*WorkerInfo, error) {
 	endpoint := w.pulsar.endpoint(w.apiVersion, w.workerPath, "cluster", "leader")
 	var workerInfo WorkerInfo
-	err := w.pulsar.restClient.Get(endpoint, &workerInfo)
+	err := w.pulsar.restClient.WithOperation("FunctionsWorker.GetClusterLeader").Get(endpoint, &workerInfo)
 	if err != nil {
 		return nil, err
 	}
@@ -91,7 +91,7 @@ func (w *worker) GetClusterLeader() (*WorkerInfo, error) {
 func (w *worker) GetAssignments() (map[string][]string, error) {
 	endpoint := w.pulsar.endpoint(w.apiVersion, w.workerPath, "assignments")
 	var assignments map[string][]string
-	err := w.pulsar.restClient.Get(endpoint, &assignments)
+	err := w.pulsar.restClient.WithOperation("FunctionsWorker.GetAssignments").Get(endpoint, &assignments)
 	if err != nil {
 		return nil, err
 	}
@@ -289,7 +289,7 @@ func (c *pulsarClient) Namespaces() Namespaces {
 func (n *namespaces) GetNamespaces(tenant string) ([]string, error) {
 	var namespaces []string
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, tenant)
-	err := n.pulsar.restClient.Get(endpoint, &namespaces)
+	err := n.pulsar.restClient.WithOperation("Namespaces.GetNamespaces").Get(endpoint, &namespaces)
 	return namespaces, err
 }
 
@@ -300,7 +300,7 @@ func (n *namespaces) GetTopics(namespace string) ([]string, error) {
 		return nil, err
 	}
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, ns.String(), "topics")
-	err = n.pulsar.restClient.Get(endpoint, &topics)
+	err = n.pulsar.restClient.WithOperation("Namespaces.GetTopics").Get(endpoint, &topics)
 	return topics, err
 }
 
@@ -311,7 +311,7 @@ func (n *namespaces) GetPolicies(namespace string) (*Policies, error) {
 		return nil, err
 	}
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, ns.String())
-	err = n.pulsar.restClient.Get(endpoint, &police)
+	err = n.pulsar.restClient.WithOperation("Namespaces.GetPolicies").Get(endpoint, &police)
 	return &police, err
 }
 
@@ -325,7 +325,7 @@ func (n *namespaces) CreateNsWithPolices(namespace string, policies Policies) er
 		return err
 	}
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, ns.String())
-	return n.pulsar.restClient.Put(endpoint, &policies)
+	return n.pulsar.restClient.WithOperation("Namespaces.CreateNsWithPolices").Put(endpoint, &policies)
 }
 
 func (n *namespaces) CreateNsWithBundlesData(namespace string, bundleData *BundlesData) error {
@@ -337,7 +337,7 @@ func (n *namespaces) CreateNsWithBundlesData(namespace string, bundleData *Bundl
 	polices := new(Policies)
 	polices.Bundles = bundleData
 
-	return n.pulsar.restClient.Put(endpoint, &polices)
+	return n.pulsar.restClient.WithOperation("Namespaces.CreateNsWithBundlesData").Put(endpoint, &polices)
 }
 
 func (n *namespaces) CreateNamespace(namespace string) error {
@@ -346,7 +346,7 @@ func (n *namespaces) CreateNamespace(namespace string) error {
 		return err
 	}
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, ns.String())
-	return n.pulsar.restClient.Put(endpoint, nil)
+	return n.pulsar.restClient.WithOperation("Namespaces.CreateNamespace").Put(endpoint, nil)
 }
 
 func (n *namespaces) DeleteNamespace(namespace string) error {
@@ -355,7 +355,7 @@ func (n *namespaces) DeleteNamespace(namespace string) error {
 		return err
 	}
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, ns.String())
-	return n.pulsar.restClient.Delete(endpoint)
+	return n.pulsar.restClient.WithOperation("Namespaces.DeleteNamespace").Delete(endpoint)
 }
 
 func (n *namespaces) DeleteNamespaceBundle(namespace string, bundleRange string) error {
@@ -364,7 +364,7 @@ func (n *namespaces) DeleteNamespaceBundle(namespace string, bundleRange string)
 		return err
 	}
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, ns.String(), bundleRange)
-	return n.pulsar.restClient.Delete(endpoint)
+	return n.pulsar.restClient.WithOperation("Namespaces.DeleteNamespaceBundle").Delete(endpoint)
 }
 
 func (n *namespaces) GetNamespaceMessageTTL(namespace string) (int, error) {
@@ -374,7 +374,7 @@ func (n *namespaces) GetNamespaceMessageTTL(namespace string) (int, error) {
 		return 0, err
 	}
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, nsName.String(), "messageTTL")
-	err = n.pulsar.restClient.Get(endpoint, &ttl)
+	err = n.pulsar.restClient.WithOperation("Namespaces.GetNamespaceMessageTTL").Get(endpoint, &ttl)
 	return ttl, err
 }
 
@@ -385,7 +385,7 @@ func (n *namespaces) SetNamespaceMessageTTL(namespace string, ttlInSeconds int)
 	}
 
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, nsName.String(), "messageTTL")
-	return n.pulsar.restClient.Post(endpoint, &ttlInSeconds)
+	return n.pulsar.restClient.WithOperation("Namespaces.SetNamespaceMessageTTL").Post(endpoint, &ttlInSeconds)
 }
 
 func (n *namespaces) SetRetention(namespace string, policy RetentionPolicies) error {
@@ -394,7 +394,7 @@ func (n *namespaces) SetRetention(namespace string, policy RetentionPolicies) er
 		return err
 	}
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, nsName.String(), "retention")
-	return n.pulsar.restClient.Post(endpoint, &policy)
+	return n.pulsar.restClient.WithOperation("Namespaces.SetRetention").Post(endpoint, &policy)
 }
 
 func (n *namespaces) GetRetention(namespace string) (*RetentionPolicies, error) {
@@ -404,7 +404,7 @@ func (n *namespaces) GetRetention(namespace string) (*RetentionPolicies, error)
 		return nil, err
 	}
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, nsName.String(), "retention")
-	err = n.pulsar.restClient.Get(endpoint, &policy)
+	err = n.pulsar.restClient.WithOperation("Namespaces.GetRetention").Get(endpoint, &policy)
 	return &policy, err
 }
 
@@ -415,7 +415,7 @@ func (n *namespaces) GetBacklogQuotaMap(namespace string) (map[BacklogQuotaType]
 		return nil, err
 	}
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, nsName.String(), "backlogQuotaMap")
-	err = n.pulsar.restClient.Get(endpoint, &backlogQuotaMap)
+	err = n.pulsar.restClient.WithOperation("Namespaces.GetBacklogQuotaMap").Get(endpoint, &backlogQuotaMap)
 	return backlogQuotaMap, err
 }
 
@@ -429,7 +429,8 @@ func (n *namespaces) SetBacklogQuota(namespace string, backlogQuota BacklogQuota
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, nsName.String(), "backlogQuota")
 	params := make(map[string]string)
 	params["backlogQuotaType"] = string(backlogQuotaType)
-	return n.pulsar.restClient.PostWithQueryParams(endpoint, &backlogQuota, params)
+	client := n.pulsar.restClient.WithOperation("Namespaces.SetBacklogQuota")
+	return client.PostWithQueryParams(endpoint, &backlogQuota, params)
 }
 
 func (n *namespaces) RemoveBacklogQuota(namespace string) error {
@@ -441,28 +442,29 @@ func (n *namespaces) RemoveBacklogQuota(namespace string) error {
 	params := map[string]string{
 		"backlogQuotaType": string(DestinationStorage),
 	}
-	return n.pulsar.restClient.DeleteWithQueryParams(endpoint, params)
+	return n.pulsar.restClient.WithOperation("Namespaces.RemoveBacklogQuota").DeleteWithQueryParams(endpoint, params)
 }
 
 func (n *namespaces) SetTopicAutoCreation(namespace NameSpaceName, config TopicAutoCreationConfig) error {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "autoTopicCreation")
-	return n.pulsar.restClient.Post(endpoint, &config)
+	return n.pulsar.restClient.WithOperation("Namespaces.SetTopicAutoCreation").Post(endpoint, &config)
 }
 
 func (n *namespaces) RemoveTopicAutoCreation(namespace NameSpaceName) error {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "autoTopicCreation")
-	return n.pulsar.restClient.Delete(endpoint)
+	return n.pulsar.restClient.WithOperation("Namespaces.RemoveTopicAutoCreation").Delete(endpoint)
 }
 
 func (n *namespaces) SetSchemaValidationEnforced(namespace NameSpaceName, schemaValidationEnforced bool) error {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "schemaValidationEnforced")
-	return n.pulsar.restClient.Post(endpoint, schemaValidationEnforced)
+	client := n.pulsar.restClient.WithOperation("Namespaces.SetSchemaValidationEnforced")
+	return client.Post(endpoint, schemaValidationEnforced)
 }
 
 func (n *namespaces) GetSchemaValidationEnforced(namespace NameSpaceName) (bool, error) {
 	var result bool
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "schemaValidationEnforced")
-	err := n.pulsar.restClient.Get(endpoint, &result)
+	err := n.pulsar.restClient.WithOperation("Namespaces.GetSchemaValidationEnforced").Get(endpoint, &result)
 	return result, err
 }
 
@@ -470,14 +472,16 @@ func (n *namespaces) SetSchemaAutoUpdateCompatibilityStrategy(namespace NameSpac
 	strategy SchemaCompatibilityStrategy,
 ) error {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "schemaAutoUpdateCompatibilityStrategy")
-	return n.pulsar.restClient.Put(endpoint, strategy.String())
+	client := n.pulsar.restClient.WithOperation("Namespaces.SetSchemaAutoUpdateCompatibilityStrategy")
+	return client.Put(endpoint, strategy.String())
 }
 
 func (n *namespaces) GetSchemaAutoUpdateCompatibilityStrategy(namespace NameSpaceName) (
 	SchemaCompatibilityStrategy, error,
 ) {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "schemaAutoUpdateCompatibilityStrategy")
-	b, err := n.pulsar.restClient.GetWithQueryParams(endpoint, nil, nil, false)
+	client := n.pulsar.restClient.WithOperation("Namespaces.GetSchemaAutoUpdateCompatibilityStrategy")
+	b, err := client.GetWithQueryParams(endpoint, nil, nil, false)
 	if err != nil {
 		return "", err
 	}
@@ -490,78 +494,78 @@ func (n *namespaces) GetSchemaAutoUpdateCompatibilityStrategy(namespace NameSpac
 
 func (n *namespaces) ClearOffloadDeleteLag(namespace NameSpaceName) error {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "offloadDeletionLagMs")
-	return n.pulsar.restClient.Delete(endpoint)
+	return n.pulsar.restClient.WithOperation("Namespaces.ClearOffloadDeleteLag").Delete(endpoint)
 }
 
 func (n *namespaces) SetOffloadDeleteLag(namespace NameSpaceName, timeMs int64) error {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "offloadDeletionLagMs")
-	return n.pulsar.restClient.Put(endpoint, timeMs)
+	return n.pulsar.restClient.WithOperation("Namespaces.SetOffloadDeleteLag").Put(endpoint, timeMs)
 }
 
 func (n *namespaces) GetOffloadDeleteLag(namespace NameSpaceName) (int64, error) {
 	var result int64
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "offloadDeletionLagMs")
-	err := n.pulsar.restClient.Get(endpoint, &result)
+	err := n.pulsar.restClient.WithOperation("Namespaces.GetOffloadDeleteLag").Get(endpoint, &result)
 	return result, err
 }
 
 func (n *namespaces) SetMaxConsumersPerSubscription(namespace NameSpaceName, max int) error {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "maxConsumersPerSubscription")
-	return n.pulsar.restClient.Post(endpoint, max)
+	return n.pulsar.restClient.WithOperation("Namespaces.SetMaxConsumersPerSubscription").Post(endpoint, max)
 }
 
 func (n *namespaces) GetMaxConsumersPerSubscription(namespace NameSpaceName) (int, error) {
 	var result int
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "maxConsumersPerSubscription")
-	err := n.pulsar.restClient.Get(endpoint, &result)
+	err := n.pulsar.restClient.WithOperation("Namespaces.GetMaxConsumersPerSubscription").Get(endpoint, &result)
 	return result, err
 }
 
 func (n *namespaces) SetOffloadThreshold(namespace NameSpaceName, threshold int64) error {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "offloadThreshold")
-	return n.pulsar.restClient.Put(endpoint, threshold)
+	return n.pulsar.restClient.WithOperation("Namespaces.SetOffloadThreshold").Put(endpoint, threshold)
 }
 
 func (n *namespaces) GetOffloadThreshold(namespace NameSpaceName) (int64, error) {
 	var result int64
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "offloadThreshold")
-	err := n.pulsar.restClient.Get(endpoint, &result)
+	err := n.pulsar.restClient.WithOperation("Namespaces.GetOffloadThreshold").Get(endpoint, &result)
 	return result, err
 }
 
 func (n *namespaces) SetMaxConsumersPerTopic(namespace NameSpaceName, max int) error {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "maxConsumersPerTopic")
-	return n.pulsar.restClient.Post(endpoint, max)
+	return n.pulsar.restClient.WithOperation("Namespaces.SetMaxConsumersPerTopic").Post(endpoint, max)
 }
 
 func (n *namespaces) GetMaxConsumersPerTopic(namespace NameSpaceName) (int, error) {
 	var result int
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "maxConsumersPerTopic")
-	err := n.pulsar.restClient.Get(endpoint, &result)
+	err := n.pulsar.restClient.WithOperation("Namespaces.GetMaxConsumersPerTopic").Get(endpoint, &result)
 	return result, err
 }
 
 func (n *namespaces) SetCompactionThreshold(namespace NameSpaceName, threshold int64) error {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "compactionThreshold")
-	return n.pulsar.restClient.Put(endpoint, threshold)
+	return n.pulsar.restClient.WithOperation("Namespaces.SetCompactionThreshold").Put(endpoint, threshold)
 }
 
 func (n *namespaces) GetCompactionThreshold(namespace NameSpaceName) (int64, error) {
 	var result int64
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "compactionThreshold")
-	err := n.pulsar.restClient.Get(endpoint, &result)
+	err := n.pulsar.restClient.WithOperation("Namespaces.GetCompactionThreshold").Get(endpoint, &result)
 	return result, err
 }
 
 func (n *namespaces) SetMaxProducersPerTopic(namespace NameSpaceName, max int) error {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "maxProducersPerTopic")
-	return n.pulsar.restClient.Post(endpoint, max)
+	return n.pulsar.restClient.WithOperation("Namespaces.SetMaxProducersPerTopic").Post(endpoint, max)
 }
 
 func (n *namespaces) GetMaxProducersPerTopic(namespace NameSpaceName) (int, error) {
 	var result int
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "maxProducersPerTopic")
-	err := n.pulsar.restClient.Get(endpoint, &result)
+	err := n.pulsar.restClient.WithOperation("Namespaces.GetMaxProducersPerTopic").Get(endpoint, &result)
 	return result, err
 }
 
@@ -572,7 +576,7 @@ func (n *namespaces) GetNamespaceReplicationClusters(namespace string) ([]string
 		return nil, err
 	}
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, nsName.String(), "replication")
-	err = n.pulsar.restClient.Get(endpoint, &data)
+	err = n.pulsar.restClient.WithOperation("Namespaces.GetNamespaceReplicationClusters").Get(endpoint, &data)
 	return data, err
 }
 
@@ -582,7 +586,7 @@ func (n *namespaces) SetNamespaceReplicationClusters(namespace string, clusterId
 		return err
 	}
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, nsName.String(), "replication")
-	return n.pulsar.restClient.Post(endpoint, &clusterIds)
+	return n.pulsar.restClient.WithOperation("Namespaces.SetNamespaceReplicationClusters").Post(endpoint, &clusterIds)
 }
 
 func (n *namespaces) SetNamespaceAntiAffinityGroup(namespace string, namespaceAntiAffinityGroup string) error {
@@ -591,7 +595,8 @@ func (n *namespaces) SetNamespaceAntiAffinityGroup(namespace string, namespaceAn
 		return err
 	}
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, nsName.String(), "antiAffinity")
-	return n.pulsar.restClient.Post(endpoint, namespaceAntiAffinityGroup)
+	client := n.pulsar.restClient.WithOperation("Namespaces.SetNamespaceAntiAffinityGroup")
+	return client.Post(endpoint, namespaceAntiAffinityGroup)
 }
 
 func (n *namespaces) GetAntiAffinityNamespaces(tenant, cluster, namespaceAntiAffinityGroup string) ([]string, error) {
@@ -600,7 +605,8 @@ func (n *namespaces) GetAntiAffinityNamespaces(tenant, cluster, namespaceAntiAff
 	params := map[string]string{
 		"property": tenant,
 	}
-	_, err := n.pulsar.restClient.GetWithQueryParams(endpoint, &data, params, false)
+	client := n.pulsar.restClient.WithOperation("Namespaces.GetAntiAffinityNamespaces")
+	_, err := client.GetWithQueryParams(endpoint, &data, params, false)
 	return data, err
 }
 
@@ -610,7 +616,8 @@ func (n *namespaces) GetNamespaceAntiAffinityGroup(namespace string) (string, er
 		return "", err
 	}
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, nsName.String(), "antiAffinity")
-	data, err := n.pulsar.restClient.GetWithQueryParams(endpoint, nil, nil, false)
+	client := n.pulsar.restClient.WithOperation("Namespaces.GetNamespaceAntiAffinityGroup")
+	data, err := client.GetWithQueryParams(endpoint, nil, nil, false)
 	return string(data), err
 }
 
@@ -620,7 +627,7 @@ func (n *namespaces) DeleteNamespaceAntiAffinityGroup(namespace string) error {
 		return err
 	}
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, nsName.String(), "antiAffinity")
-	return n.pulsar.restClient.Delete(endpoint)
+	return n.pulsar.restClient.WithOperation("Namespaces.DeleteNamespaceAntiAffinityGroup").Delete(endpoint)
 }
 
 func (n *namespaces) SetDeduplicationStatus(namespace string, enableDeduplication bool) error {
@@ -629,7 +636,7 @@ func (n *namespaces) SetDeduplicationStatus(namespace string, enableDeduplicatio
 		return err
 	}
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, nsName.String(), "deduplication")
-	return n.pulsar.restClient.Post(endpoint, enableDeduplication)
+	return n.pulsar.restClient.WithOperation("Namespaces.SetDeduplicationStatus").Post(endpoint, enableDeduplication)
 }
 
 func (n *namespaces) SetPersistence(namespace string, persistence PersistencePolicies) error {
@@ -638,7 +645,7 @@ func (n *namespaces) SetPersistence(namespace string, persistence PersistencePol
 		return err
 	}
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, nsName.String(), "persistence")
-	return n.pulsar.restClient.Post(endpoint, &persistence)
+	return n.pulsar.restClient.WithOperation("Namespaces.SetPersistence").Post(endpoint, &persistence)
 }
 
 func (n *namespaces) SetBookieAffinityGroup(namespace string, bookieAffinityGroup BookieAffinityGroupData) error {
@@ -647,7 +654,7 @@ func (n *namespaces) SetBookieAffinityGroup(namespace string, bookieAffinityGrou
 		return err
 	}
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, nsName.String(), "persistence", "bookieAffinity")
-	return n.pulsar.restClient.Post(endpoint, &bookieAffinityGroup)
+	return n.pulsar.restClient.WithOperation("Namespaces.SetBookieAffinityGroup").Post(endpoint, &bookieAffinityGroup)
 }
 
 func (n *namespaces) DeleteBookieAffinityGroup(namespace string) error {
@@ -656,7 +663,7 @@ func (n *namespaces) DeleteBookieAffinityGroup(namespace string) error {
 		return err
 	}
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, nsName.String(), "persistence", "bookieAffinity")
-	return n.pulsar.restClient.Delete(endpoint)
+	return n.pulsar.restClient.WithOperation("Namespaces.DeleteBookieAffinityGroup").Delete(endpoint)
 }
 
 func (n *namespaces) GetBookieAffinityGroup(namespace string) (*BookieAffinityGroupData, error) {
@@ -666,7 +673,7 @@ func (n *namespaces) GetBookieAffinityGroup(namespace string) (*BookieAffinityGr
 		return nil, err
 	}
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, nsName.String(), "persistence", "bookieAffinity")
-	err = n.pulsar.restClient.Get(endpoint, &data)
+	err = n.pulsar.restClient.WithOperation("Namespaces.GetBookieAffinityGroup").Get(endpoint, &data)
 	return &data, err
 }
 
@@ -677,7 +684,7 @@ func (n *namespaces) GetPersistence(namespace string) (*PersistencePolicies, err
 		return nil, err
 	}
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, nsName.String(), "persistence")
-	err = n.pulsar.restClient.Get(endpoint, &persistence)
+	err = n.pulsar.restClient.WithOperation("Namespaces.GetPersistence").Get(endpoint, &persistence)
 	return &persistence, err
 }
 
@@ -687,7 +694,7 @@ func (n *namespaces) Unload(namespace string) error {
 		return err
 	}
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, nsName.String(), "unload")
-	return n.pulsar.restClient.Put(endpoint, nil)
+	return n.pulsar.restClient.WithOperation("Namespaces.Unload").Put(endpoint, nil)
 }
 
 func (n *namespaces) UnloadNamespaceBundle(namespace, bundle string) error {
@@ -696,7 +703,7 @@ func (n *namespaces) UnloadNamespaceBundle(namespace, bundle string) error {
 		return err
 	}
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, nsName.String(), bundle, "unload")
-	return n.pulsar.restClient.Put(endpoint, nil)
+	return n.pulsar.restClient.WithOperation("Namespaces.UnloadNamespaceBundle").Put(endpoint, nil)
 }
 
 func (n *namespaces) SplitNamespaceBundle(namespace, bundle string, unloadSplitBundles bool) error {
@@ -708,13 +715,14 @@ func (n *namespaces) SplitNamespaceBundle(namespace, bundle string, unloadSplitB
 	params := map[string]string{
 		"unload": strconv.FormatBool(unloadSplitBundles),
 	}
-	return n.pulsar.restClient.PutWithQueryParams(endpoint, nil, nil, params)
+	client := n.pulsar.restClient.WithOperation("Namespaces.SplitNamespaceBundle")
+	return client.PutWithQueryParams(endpoint, nil, nil, params)
 }
 
 func (n *namespaces) GetNamespacePermissions(namespace NameSpaceName) (map[string][]AuthAction, error) {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "permissions")
 	var permissions map[string][]AuthAction
-	err := n.pulsar.restClient.Get(endpoint, &permissions)
+	err := n.pulsar.restClient.WithOperation("Namespaces.GetNamespacePermissions").Get(endpoint, &permissions)
 	return permissions, err
 }
 
@@ -726,45 +734,46 @@ func (n *namespaces) GrantNamespacePermission(namespace NameSpaceName, role stri
 	for _, v := range action {
 		s = append(s, v.String())
 	}
-	return n.pulsar.restClient.Post(endpoint, s)
+	return n.pulsar.restClient.WithOperation("Namespaces.GrantNamespacePermission").Post(endpoint, s)
 }
 
 func (n *namespaces) RevokeNamespacePermission(namespace NameSpaceName, role string) error {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "permissions", role)
-	return n.pulsar.restClient.Delete(endpoint)
+	return n.pulsar.restClient.WithOperation("Namespaces.RevokeNamespacePermission").Delete(endpoint)
 }
 
 func (n *namespaces) GrantSubPermission(namespace NameSpaceName, sName string, roles []string) error {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "permissions",
 		"subscription", sName)
-	return n.pulsar.restClient.Post(endpoint, roles)
+	return n.pulsar.restClient.WithOperation("Namespaces.GrantSubPermission").Post(endpoint, roles)
 }
 
 func (n *namespaces) RevokeSubPermission(namespace NameSpaceName, sName, role string) error {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "permissions",
 		"subscription", sName, role)
-	return n.pulsar.restClient.Delete(endpoint)
+	return n.pulsar.restClient.WithOperation("Namespaces.RevokeSubPermission").Delete(endpoint)
 }
 
 func (n *namespaces) SetSubscriptionAuthMode(namespace NameSpaceName, mode SubscriptionAuthMode) error {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "subscriptionAuthMode")
-	return n.pulsar.restClient.Post(endpoint, mode.String())
+	return n.pulsar.restClient.WithOperation("Namespaces.SetSubscriptionAuthMode").Post(endpoint, mode.String())
 }
 
 func (n *namespaces) SetEncryptionRequiredStatus(namespace NameSpaceName, encrypt bool) error {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "encryptionRequired")
-	return n.pulsar.restClient.Post(endpoint, strconv.FormatBool(encrypt))
+	client := n.pulsar.restClient.WithOperation("Namespaces.SetEncryptionRequiredStatus")
+	return client.Post(endpoint, strconv.FormatBool(encrypt))
 }
 
 func (n *namespaces) UnsubscribeNamespace(namespace NameSpaceName, sName string) error {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "unsubscribe", url.QueryEscape(sName))
-	return n.pulsar.restClient.Post(endpoint, nil)
+	return n.pulsar.restClient.WithOperation("Namespaces.UnsubscribeNamespace").Post(endpoint, nil)
 }
 
 func (n *namespaces) UnsubscribeNamespaceBundle(namespace NameSpaceName, bundle, sName string) error {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), bundle, "unsubscribe",
 		url.QueryEscape(sName))
-	return n.pulsar.restClient.Post(endpoint, nil)
+	return n.pulsar.restClient.WithOperation("Namespaces.UnsubscribeNamespaceBundle").Post(endpoint, nil)
 }
 
 func (n *namespaces) ClearNamespaceBundleBacklogForSubscription(namespace NameSpaceName,
@@ -772,109 +781,110 @@ func (n *namespaces) ClearNamespaceBundleBacklogForSubscription(namespace NameSp
 ) error {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), bundle, "clearBacklog",
 		url.QueryEscape(sName))
-	return n.pulsar.restClient.Post(endpoint, nil)
+	return n.pulsar.restClient.WithOperation("Namespaces.ClearNamespaceBundleBacklogForSubscription").Post(endpoint, nil)
 }
 
 func (n *namespaces) ClearNamespaceBundleBacklog(namespace NameSpaceName, bundle string) error {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), bundle, "clearBacklog")
-	return n.pulsar.restClient.Post(endpoint, nil)
+	return n.pulsar.restClient.WithOperation("Namespaces.ClearNamespaceBundleBacklog").Post(endpoint, nil)
 }
 
 func (n *namespaces) ClearNamespaceBacklogForSubscription(namespace NameSpaceName, sName string) error {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "clearBacklog", url.QueryEscape(sName))
-	return n.pulsar.restClient.Post(endpoint, nil)
+	return n.pulsar.restClient.WithOperation("Namespaces.ClearNamespaceBacklogForSubscription").Post(endpoint, nil)
 }
 
 func (n *namespaces) ClearNamespaceBacklog(namespace NameSpaceName) error {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "clearBacklog")
-	return n.pulsar.restClient.Post(endpoint, nil)
+	return n.pulsar.restClient.WithOperation("Namespaces.ClearNamespaceBacklog").Post(endpoint, nil)
 }
 
 func (n *namespaces) SetReplicatorDispatchRate(namespace NameSpaceName, rate DispatchRate) error {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "replicatorDispatchRate")
-	return n.pulsar.restClient.Post(endpoint, rate)
+	return n.pulsar.restClient.WithOperation("Namespaces.SetReplicatorDispatchRate").Post(endpoint, rate)
 }
 
 func (n *namespaces) GetReplicatorDispatchRate(namespace NameSpaceName) (DispatchRate, error) {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "replicatorDispatchRate")
 	var rate DispatchRate
-	err := n.pulsar.restClient.Get(endpoint, &rate)
+	err := n.pulsar.restClient.WithOperation("Namespaces.GetReplicatorDispatchRate").Get(endpoint, &rate)
 	return rate, err
 }
 
 func (n *namespaces) SetSubscriptionDispatchRate(namespace NameSpaceName, rate DispatchRate) error {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "subscriptionDispatchRate")
-	return n.pulsar.restClient.Post(endpoint, rate)
+	return n.pulsar.restClient.WithOperation("Namespaces.SetSubscriptionDispatchRate").Post(endpoint, rate)
 }
 
 func (n *namespaces) GetSubscriptionDispatchRate(namespace NameSpaceName) (DispatchRate, error) {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "subscriptionDispatchRate")
 	var rate DispatchRate
-	err := n.pulsar.restClient.Get(endpoint, &rate)
+	err := n.pulsar.restClient.WithOperation("Namespaces.GetSubscriptionDispatchRate").Get(endpoint, &rate)
 	return rate, err
 }
 
 func (n *namespaces) SetSubscribeRate(namespace NameSpaceName, rate SubscribeRate) error {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "subscribeRate")
-	return n.pulsar.restClient.Post(endpoint, rate)
+	return n.pulsar.restClient.WithOperation("Namespaces.SetSubscribeRate").Post(endpoint, rate)
 }
 
 func (n *namespaces) GetSubscribeRate(namespace NameSpaceName) (SubscribeRate, error) {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "subscribeRate")
 	var rate SubscribeRate
-	err := n.pulsar.restClient.Get(endpoint, &rate)
+	err := n.pulsar.restClient.WithOperation("Namespaces.GetSubscribeRate").Get(endpoint, &rate)
 	return rate, err
 }
 
 func (n *namespaces) SetDispatchRate(namespace NameSpaceName, rate DispatchRate) error {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "dispatchRate")
-	return n.pulsar.restClient.Post(endpoint, rate)
+	return n.pulsar.restClient.WithOperation("Namespaces.SetDispatchRate").Post(endpoint, rate)
 }
 
 func (n *namespaces) GetDispatchRate(namespace NameSpaceName) (DispatchRate, error) {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "dispatchRate")
 	var rate DispatchRate
-	err := n.pulsar.restClient.Get(endpoint, &rate)
+	err := n.pulsar.restClient.WithOperation("Namespaces.GetDispatchRate").Get(endpoint, &rate)
 	return rate, err
 }
 
 func (n *namespaces) SetPublishRate(namespace NameSpaceName, pubRate PublishRate) error {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "publishRate")
-	return n.pulsar.restClient.Post(endpoint, pubRate)
+	return n.pulsar.restClient.WithOperation("Namespaces.SetPublishRate").Post(endpoint, pubRate)
 }
 
 func (n *namespaces) GetPublishRate(namespace NameSpaceName) (PublishRate, error) {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "publishRate")
 	var pubRate PublishRate
-	err := n.pulsar.restClient.Get(endpoint, &pubRate)
+	err := n.pulsar.restClient.WithOperation("Namespaces.GetPublishRate").Get(endpoint, &pubRate)
 	return pubRate, err
 }
 
 func (n *namespaces) SetIsAllowAutoUpdateSchema(namespace NameSpaceName, isAllowAutoUpdateSchema bool) error {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "isAllowAutoUpdateSchema")
-	return n.pulsar.restClient.Post(endpoint, &isAllowAutoUpdateSchema)
+	client := n.pulsar.restClient.WithOperation("Namespaces.SetIsAllowAutoUpdateSchema")
+	return client.Post(endpoint, &isAllowAutoUpdateSchema)
 }
 
 func (n *namespaces) GetIsAllowAutoUpdateSchema(namespace NameSpaceName) (bool, error) {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "isAllowAutoUpdateSchema")
 	var result bool
-	err := n.pulsar.restClient.Get(endpoint, &result)
+	err := n.pulsar.restClient.WithOperation("Namespaces.GetIsAllowAutoUpdateSchema").Get(endpoint, &result)
 	return result, err
 }
 
 func (n *namespaces) GetInactiveTopicPolicies(namespace NameSpaceName) (InactiveTopicPolicies, error) {
 	var out InactiveTopicPolicies
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "inactiveTopicPolicies")
-	err := n.pulsar.restClient.Get(endpoint, &out)
+	err := n.pulsar.restClient.WithOperation("Namespaces.GetInactiveTopicPolicies").Get(endpoint, &out)
 	return out, err
 }
 
 func (n *namespaces) RemoveInactiveTopicPolicies(namespace NameSpaceName) error {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "inactiveTopicPolicies")
-	return n.pulsar.restClient.Delete(endpoint)
+	return n.pulsar.restClient.WithOperation("Namespaces.RemoveInactiveTopicPolicies").Delete(endpoint)
 }
 
 func (n *namespaces) SetInactiveTopicPolicies(namespace NameSpaceName, data InactiveTopicPolicies) error {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, namespace.String(), "inactiveTopicPolicies")
-	return n.pulsar.restClient.Post(endpoint, data)
+	return n.pulsar.restClient.WithOperation("Namespaces.SetInactiveTopicPolicies").Post(endpoint, data)
 }
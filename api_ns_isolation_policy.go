@@ -59,12 +59,13 @@ func (n *nsIsolationPolicy) setNamespaceIsolationPolicy(cluster, policyName stri
 	namespaceIsolationData NamespaceIsolationData,
 ) error {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, cluster, "namespaceIsolationPolicies", policyName)
-	return n.pulsar.restClient.Post(endpoint, &namespaceIsolationData)
+	client := n.pulsar.restClient.WithOperation("NsIsolationPolicy.CreateNamespaceIsolationPolicy")
+	return client.Post(endpoint, &namespaceIsolationData)
 }
 
 func (n *nsIsolationPolicy) DeleteNamespaceIsolationPolicy(cluster, policyName string) error {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, cluster, "namespaceIsolationPolicies", policyName)
-	return n.pulsar.restClient.Delete(endpoint)
+	return n.pulsar.restClient.WithOperation("NsIsolationPolicy.DeleteNamespaceIsolationPolicy").Delete(endpoint)
 }
 
 func (n *nsIsolationPolicy) GetNamespaceIsolationPolicy(cluster, policyName string) (
@@ -72,7 +73,8 @@ func (n *nsIsolationPolicy) GetNamespaceIsolationPolicy(cluster, policyName stri
 ) {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, cluster, "namespaceIsolationPolicies", policyName)
 	var nsIsolationData NamespaceIsolationData
-	err := n.pulsar.restClient.Get(endpoint, &nsIsolationData)
+	client := n.pulsar.restClient.WithOperation("NsIsolationPolicy.GetNamespaceIsolationPolicy")
+	err := client.Get(endpoint, &nsIsolationData)
 	if err != nil {
 		return nil, err
 	}
@@ -84,7 +86,7 @@ func (n *nsIsolationPolicy) GetNamespaceIsolationPolicies(cluster string) (
 ) {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, cluster, "namespaceIsolationPolicies")
 	var tmpMap map[string]NamespaceIsolationData
-	err := n.pulsar.restClient.Get(endpoint, &tmpMap)
+	err := n.pulsar.restClient.WithOperation("NsIsolationPolicy.GetNamespaceIsolationPolicies").Get(endpoint, &tmpMap)
 	if err != nil {
 		return nil, err
 	}
@@ -96,7 +98,8 @@ func (n *nsIsolationPolicy) GetBrokersWithNamespaceIsolationPolicy(cluster strin
 ) {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, cluster, "namespaceIsolationPolicies", "brokers")
 	var res []BrokerNamespaceIsolationData
-	err := n.pulsar.restClient.Get(endpoint, &res)
+	client := n.pulsar.restClient.WithOperation("NsIsolationPolicy.GetBrokersWithNamespaceIsolationPolicy")
+	err := client.Get(endpoint, &res)
 	if err != nil {
 		return nil, err
 	}
@@ -108,7 +111,8 @@ func (n *nsIsolationPolicy) GetBrokerWithNamespaceIsolationPolicy(cluster,
 ) (*BrokerNamespaceIsolationData, error) {
 	endpoint := n.pulsar.endpoint(n.apiVersion, n.basePath, cluster, "namespaceIsolationPolicies", "brokers", broker)
 	var brokerNamespaceIsolationData BrokerNamespaceIsolationData
-	err := n.pulsar.restClient.Get(endpoint, &brokerNamespaceIsolationData)
+	client := n.pulsar.restClient.WithOperation("NsIsolationPolicy.GetBrokerWithNamespaceIsolationPolicy")
+	err := client.Get(endpoint, &brokerNamespaceIsolationData)
 	if err != nil {
 		return nil, err
 	}
@@ -16,18 +16,18 @@
 package pulsaradmin
 
 import (
-	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
-	"mime/multipart"
-	"net/textproto"
 	"os"
-	"path"
 	"path/filepath"
 	"strings"
 
 	"github.com/pkg/errors"
+	"github.com/streamnative/pulsar-admin-go/internal/rest"
 )
 
 // Packages is admin interface for functions management
@@ -39,6 +39,16 @@ type Packages interface {
 	//        the package URL
 	Download(packageURL, destinationFile string) error
 
+	// DownloadWithOptions downloads a Function/Connector Package to destinationFile, like Download, optionally
+	// through a temporary file and verifying its checksum
+	DownloadWithOptions(packageURL, destinationFile string, options *DownloadOptions) error
+
+	// DownloadTo writes a Function/Connector Package to w, bound to ctx. When verifyChecksum is set, the
+	// package is verified against the SHA-256 checksum recorded in its metadata: ErrPackageChecksumMissing is
+	// returned, before anything is written, when the metadata records no checksum, and
+	// ErrPackageChecksumMismatch, once the package was written, when they differ
+	DownloadTo(ctx context.Context, packageURL string, w io.Writer, verifyChecksum bool) error
+
 	// Upload Function/Connector Package
 	// @param filePath
 	//        file where data should be uploaded to
@@ -52,6 +62,14 @@ type Packages interface {
 	// 		  external infromations of a package
 	Upload(packageURL, filePath, description, contact string, properties map[string]string) error
 
+	// UploadWithReader uploads a Function/Connector Package read from r, streamed as it is read
+	// @param fileName
+	//        name of the uploaded file
+	// @param options
+	//        the size of the package and the progress callback, or nil
+	UploadWithReader(packageURL, fileName string, r io.Reader, description, contact string,
+		properties map[string]string, options *UploadOptions) error
+
 	// List all the packages with the given type in a namespace
 	List(typeName, namespace string) ([]string, error)
 
@@ -64,23 +82,30 @@ type Packages interface {
 	// GetMetadata get a package metadata information
 	GetMetadata(packageURL string) (PackageMetadata, error)
 
-	// UpdateMetadata update a package metadata information
+	// UpdateMetadata update a package metadata information. The SHA-256 checksum recorded by Upload is kept
+	// unless properties sets PackageSHA256Property
 	UpdateMetadata(packageURL, description, contact string, properties map[string]string) error
 }
 
+// DownloadOptions configures the download of a package to a file.
+type DownloadOptions struct {
+	// Atomic downloads the package to a temporary file next to the destination
+	// file, moved to it once complete, so that the destination file never
+	// holds a partial package. A file created at the destination during the
+	// download is kept, and the download fails.
+	Atomic bool
+	// VerifyChecksum verifies the package against the SHA-256 checksum
+	// recorded in its metadata by Upload. ErrPackageChecksumMissing is
+	// returned for a package without a recorded checksum.
+	VerifyChecksum bool
+}
+
 type packages struct {
 	pulsar     *pulsarClient
 	basePath   string
 	apiVersion APIVersion
 }
 
-func (p *packages) createStringFromField(w *multipart.Writer, value string) (io.Writer, error) {
-	h := make(textproto.MIMEHeader)
-	h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s" `, value))
-	h.Set("Content-Type", "application/json")
-	return w.CreatePart(h)
-}
-
 // Packages is used to access the functions endpoints
 func (c *pulsarClient) Packages() Packages {
 	return &packages{
@@ -91,112 +116,197 @@ func (c *pulsarClient) Packages() Packages {
 }
 
 func (p packages) Download(packageURL, destinationFile string) error {
-	packageName, err := GetPackageName(packageURL)
-	if err != nil {
-		return err
-	}
-	endpoint := p.pulsar.endpoint(p.apiVersion, p.basePath, string(packageName.GetType()), packageName.GetTenant(),
-		packageName.GetNamespace(), packageName.GetName(), packageName.GetVersion())
+	return p.DownloadWithOptions(packageURL, destinationFile, nil)
+}
 
-	parent := path.Dir(destinationFile)
+func (p packages) DownloadWithOptions(packageURL, destinationFile string, options *DownloadOptions) error {
+	if options == nil {
+		options = &DownloadOptions{}
+	}
+	parent := filepath.Dir(destinationFile)
 	if parent != "." {
-		err = os.MkdirAll(parent, 0o755)
+		err := os.MkdirAll(parent, 0o755)
 		if err != nil {
 			return fmt.Errorf("failed to create parent directory %s: %w", parent, err)
 		}
 	}
 
-	_, err = os.Open(destinationFile)
-	if err != nil {
-		if !os.IsNotExist(err) {
-			return fmt.Errorf("file %s already exists, please delete "+
-				"the file first or change the file name", destinationFile)
+	exists := fmt.Errorf("file %s already exists, please delete "+
+		"the file first or change the file name", destinationFile)
+	if _, err := os.Stat(destinationFile); err == nil {
+		return exists
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	var file *os.File
+	var err error
+	if options.Atomic {
+		file, err = os.CreateTemp(parent, "."+filepath.Base(destinationFile)+".*.tmp")
+		if err == nil {
+			err = file.Chmod(0o644)
+		}
+	} else {
+		file, err = os.OpenFile(destinationFile, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+		if os.IsExist(err) {
+			return exists
 		}
 	}
-	file, err := os.Create(destinationFile)
 	if err != nil {
+		if file != nil {
+			file.Close()
+			os.Remove(file.Name())
+		}
 		return err
 	}
 
-	_, err = p.pulsar.restClient.GetWithOptions(endpoint, nil, nil, false, file)
+	client := p.pulsar.restClient.WithOperation("Packages.DownloadWithOptions")
+	err = p.download(client, packageURL, file, options.VerifyChecksum)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if options.Atomic {
+		if err == nil {
+			err = publish(file.Name(), destinationFile, exists)
+		}
+		os.Remove(file.Name())
+		return err
+	}
 	if err != nil {
+		// the file was created by the download, and holds a partial package
+		os.Remove(file.Name())
 		return err
 	}
 	return nil
 }
 
-func (p packages) Upload(packageURL, filePath, description, contact string, properties map[string]string) error {
-	if strings.TrimSpace(filePath) == "" {
-		return errors.New("file path is empty")
+// publish moves the downloaded temporary file to the destination file, unless
+// a file was created there during the download. A hard link, unlike a rename,
+// fails when the destination exists; where hard links are not supported, the
+// destination is checked right before the rename.
+func publish(tempFile, destinationFile string, exists error) error {
+	err := os.Link(tempFile, destinationFile)
+	if err == nil {
+		return nil
 	}
-	if strings.TrimSpace(packageURL) == "" {
-		return errors.New("package URL is empty")
+	if os.IsExist(err) {
+		return exists
 	}
-	packageName, err := GetPackageName(packageURL)
-	if err != nil {
+	if _, err := os.Lstat(destinationFile); err == nil {
+		return exists
+	} else if !os.IsNotExist(err) {
 		return err
 	}
-	endpoint := p.pulsar.endpoint(p.apiVersion, p.basePath, string(packageName.GetType()), packageName.GetTenant(),
-		packageName.GetNamespace(), packageName.GetName(), packageName.GetVersion())
-	metadata := PackageMetadata{
-		Description: description,
-		Contact:     contact,
-		Properties:  properties,
-	}
-	// buffer to store our request as bytes
-	bodyBuf := bytes.NewBufferString("")
+	return os.Rename(tempFile, destinationFile)
+}
 
-	multiPartWriter := multipart.NewWriter(bodyBuf)
+func (p packages) DownloadTo(ctx context.Context, packageURL string, w io.Writer, verifyChecksum bool) error {
+	client := p.pulsar.restClient.WithOperation("Packages.DownloadTo")
+	return p.download(client.WithContext(ctx), packageURL, w, verifyChecksum)
+}
 
-	metadataJSON, err := json.Marshal(metadata)
+// download writes a package to w. When verify is set, the package is verified
+// against the checksum recorded in its metadata.
+func (p packages) download(client *rest.Client, packageURL string, w io.Writer, verify bool) error {
+	packageName, err := GetPackageName(packageURL)
 	if err != nil {
 		return err
 	}
+	parts := []string{
+		string(packageName.GetType()), packageName.GetTenant(), packageName.GetNamespace(),
+		packageName.GetName(), packageName.GetVersion(),
+	}
 
-	stringWriter, err := p.createStringFromField(multiPartWriter, "metadata")
-	if err != nil {
-		return err
+	var expected string
+	if verify {
+		var metadata PackageMetadata
+		endpoint := p.pulsar.endpoint(p.apiVersion, p.basePath, append(parts, "metadata")...)
+		if err := client.Get(endpoint, &metadata); err != nil {
+			return err
+		}
+		expected = metadata.Properties[PackageSHA256Property]
+		if expected == "" {
+			return fmt.Errorf("%w: package %s has no %s property", ErrPackageChecksumMissing, packageURL,
+				PackageSHA256Property)
+		}
 	}
 
-	_, err = stringWriter.Write(metadataJSON)
-	if err != nil {
+	checksum := sha256.New()
+	if verify {
+		w = io.MultiWriter(w, checksum)
+	}
+	endpoint := p.pulsar.endpoint(p.apiVersion, p.basePath, parts...)
+	if _, err := client.GetWithOptions(endpoint, nil, nil, false, w); err != nil {
 		return err
 	}
+	if verify {
+		if actual := hex.EncodeToString(checksum.Sum(nil)); !strings.EqualFold(actual, expected) {
+			return fmt.Errorf("%w: package %s has SHA-256 %s, expected %s", ErrPackageChecksumMismatch,
+				packageURL, actual, expected)
+		}
+	}
+	return nil
+}
 
-	file, err := os.Open(filePath)
-	if err != nil {
-		return err
+func (p packages) Upload(packageURL, filePath, description, contact string, properties map[string]string) error {
+	if strings.TrimSpace(filePath) == "" {
+		return errors.New("file path is empty")
 	}
-	defer file.Close()
+	return uploadFile(filePath, func(fileName string, r io.Reader) error {
+		client := p.pulsar.restClient.WithOperation("Packages.Upload")
+		return p.upload(client, packageURL, fileName, r, description, contact, properties, nil)
+	})
+}
 
-	part, err := multiPartWriter.CreateFormFile("file", filepath.Base(file.Name()))
-	if err != nil {
-		return err
+func (p packages) UploadWithReader(packageURL, fileName string, r io.Reader, description, contact string,
+	properties map[string]string, options *UploadOptions,
+) error {
+	if r == nil {
+		return errors.New("reader is nil")
 	}
+	client := p.pulsar.restClient.WithOperation("Packages.UploadWithReader")
+	return p.upload(client, packageURL, fileName, r, description, contact, properties, options)
+}
 
-	// copy the actual file content to the filed's writer
-	_, err = io.Copy(part, file)
+func (p packages) upload(client *rest.Client, packageURL, fileName string, r io.Reader, description, contact string,
+	properties map[string]string, options *UploadOptions,
+) error {
+	if strings.TrimSpace(packageURL) == "" {
+		return errors.New("package URL is empty")
+	}
+	packageName, err := GetPackageName(packageURL)
 	if err != nil {
 		return err
 	}
+	endpoint := p.pulsar.endpoint(p.apiVersion, p.basePath, string(packageName.GetType()), packageName.GetTenant(),
+		packageName.GetNamespace(), packageName.GetName(), packageName.GetVersion())
 
-	if err = multiPartWriter.Close(); err != nil {
+	upload := newMultipartUpload(options)
+	if err := upload.setFile("file", filepath.Base(fileName), r); err != nil {
 		return err
 	}
-
-	contentType := multiPartWriter.FormDataContentType()
-	err = p.pulsar.restClient.PostWithMultiPart(endpoint, nil, bodyBuf, contentType)
-	if err != nil {
-		return err
+	// The metadata is sent after the package, with the checksum of the package.
+	upload.checksumField = func(checksum []byte) (multipartField, error) {
+		metadata := PackageMetadata{
+			Description: description,
+			Contact:     contact,
+			Properties:  map[string]string{PackageSHA256Property: hex.EncodeToString(checksum)},
+		}
+		for k, v := range properties {
+			if k != PackageSHA256Property {
+				metadata.Properties[k] = v
+			}
+		}
+		metadataJSON, err := json.Marshal(metadata)
+		return multipartField{name: "metadata", contentType: "application/json", data: metadataJSON}, err
 	}
-
-	return nil
+	return upload.post(client, endpoint)
 }
 
 func (p packages) List(typeName, namespace string) ([]string, error) {
 	var packageList []string
 	endpoint := p.pulsar.endpoint(p.apiVersion, p.basePath, typeName, namespace)
-	err := p.pulsar.restClient.Get(endpoint, &packageList)
+	err := p.pulsar.restClient.WithOperation("Packages.List").Get(endpoint, &packageList)
 	return packageList, err
 }
 
@@ -208,7 +318,7 @@ func (p packages) ListVersions(packageURL string) ([]string, error) {
 	}
 	endpoint := p.pulsar.endpoint(p.apiVersion, p.basePath, string(packageName.GetType()), packageName.GetTenant(),
 		packageName.GetNamespace(), packageName.GetName())
-	err = p.pulsar.restClient.Get(endpoint, &versionList)
+	err = p.pulsar.restClient.WithOperation("Packages.ListVersions").Get(endpoint, &versionList)
 	return versionList, err
 }
 
@@ -220,7 +330,7 @@ func (p packages) Delete(packageURL string) error {
 	endpoint := p.pulsar.endpoint(p.apiVersion, p.basePath, string(packageName.GetType()), packageName.GetTenant(),
 		packageName.GetNamespace(), packageName.GetName(), packageName.GetVersion())
 
-	return p.pulsar.restClient.Delete(endpoint)
+	return p.pulsar.restClient.WithOperation("Packages.Delete").Delete(endpoint)
 }
 
 func (p packages) GetMetadata(packageURL string) (PackageMetadata, error) {
@@ -231,7 +341,7 @@ func (p packages) GetMetadata(packageURL string) (PackageMetadata, error) {
 	}
 	endpoint := p.pulsar.endpoint(p.apiVersion, p.basePath, string(packageName.GetType()), packageName.GetTenant(),
 		packageName.GetNamespace(), packageName.GetName(), packageName.GetVersion(), "metadata")
-	err = p.pulsar.restClient.Get(endpoint, &metadata)
+	err = p.pulsar.restClient.WithOperation("Packages.GetMetadata").Get(endpoint, &metadata)
 	return metadata, err
 }
 
@@ -248,5 +358,20 @@ func (p packages) UpdateMetadata(packageURL, description, contact string, proper
 	endpoint := p.pulsar.endpoint(p.apiVersion, p.basePath, string(packageName.GetType()), packageName.GetTenant(),
 		packageName.GetNamespace(), packageName.GetName(), packageName.GetVersion(), "metadata")
 
-	return p.pulsar.restClient.Put(endpoint, &metadata)
+	// The metadata is replaced as a whole, so that the checksum recorded by
+	// Upload is copied over unless the caller sets its own.
+	if _, ok := properties[PackageSHA256Property]; !ok {
+		var current PackageMetadata
+		if err := p.pulsar.restClient.WithOperation("Packages.UpdateMetadata").Get(endpoint, &current); err != nil {
+			return err
+		}
+		if checksum, ok := current.Properties[PackageSHA256Property]; ok {
+			metadata.Properties = map[string]string{PackageSHA256Property: checksum}
+			for k, v := range properties {
+				metadata.Properties[k] = v
+			}
+		}
+	}
+
+	return p.pulsar.restClient.WithOperation("Packages.UpdateMetadata").Put(endpoint, &metadata)
 }
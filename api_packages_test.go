@@ -0,0 +1,52 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsaradmin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDownloadAtomicKeepsFileCreatedDuringDownload(t *testing.T) {
+	dir := t.TempDir()
+	dst := filepath.Join(dir, "fn.jar")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// another process creates the destination file while the package
+		// is downloaded
+		require.NoError(t, os.WriteFile(dst, []byte("theirs"), 0o600))
+		_, _ = w.Write([]byte("package"))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{WebServiceURL: server.URL})
+	require.NoError(t, err)
+
+	err = client.Packages().DownloadWithOptions("function://public/default/fn@v1", dst,
+		&DownloadOptions{Atomic: true})
+	assert.ErrorContains(t, err, "already exists")
+	data, err := os.ReadFile(dst)
+	require.NoError(t, err)
+	assert.Equal(t, "theirs", string(data))
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
@@ -0,0 +1,179 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsaradmin
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// apiResource binds a field of an APIProfile to the API versions the broker
+// serves it under.
+type apiResource struct {
+	name      string
+	version   *APIVersion
+	supported []APIVersion
+}
+
+func (p *APIProfile) resources() []apiResource {
+	return []apiResource{
+		{"Clusters", &p.Clusters, []APIVersion{APIV1, APIV2}},
+		{"Functions", &p.Functions, []APIVersion{APIV2, APIV3}},
+		{"Tenants", &p.Tenants, []APIVersion{APIV2}},
+		{"Topics", &p.Topics, []APIVersion{APIV1, APIV2}},
+		{"Sources", &p.Sources, []APIVersion{APIV3}},
+		{"Sinks", &p.Sinks, []APIVersion{APIV3}},
+		{"Namespaces", &p.Namespaces, []APIVersion{APIV1, APIV2}},
+		{"Schemas", &p.Schemas, []APIVersion{APIV1, APIV2}},
+		{"NsIsolationPolicy", &p.NsIsolationPolicy, []APIVersion{APIV1, APIV2}},
+		{"Brokers", &p.Brokers, []APIVersion{APIV1, APIV2}},
+		{"BrokerStats", &p.BrokerStats, []APIVersion{APIV1, APIV2}},
+		{"ResourceQuotas", &p.ResourceQuotas, []APIVersion{APIV1, APIV2}},
+		{"FunctionsWorker", &p.FunctionsWorker, []APIVersion{APIV2}},
+		{"Packages", &p.Packages, []APIVersion{APIV3}},
+	}
+}
+
+// withDefaults returns a copy of the profile where every undefined resource
+// version is replaced by the default one.
+func (p *APIProfile) withDefaults() APIProfile {
+	profile := *p
+	defaults := defaultAPIProfile().resources()
+	for i, r := range profile.resources() {
+		if *r.version == undefined {
+			*r.version = *defaults[i].version
+		}
+	}
+	return profile
+}
+
+// Validate returns an error if the profile requests an API version that the
+// broker does not serve for a given resource. Undefined versions are valid and
+// resolve to the default version of the resource.
+func (p APIProfile) Validate() error {
+	for _, r := range p.resources() {
+		if *r.version == undefined {
+			continue
+		}
+		if !containsAPIVersion(r.supported, *r.version) {
+			return fmt.Errorf("unsupported API version %s for %s, supported versions are %s",
+				apiVersionName(*r.version), r.name, apiVersionNames(r.supported))
+		}
+	}
+	return nil
+}
+
+// DetectAPIProfile asks the broker at config.WebServiceURL for its version and
+// returns the API profile best suited to it. The result can be assigned to
+// ClientConfig.APIProfile; any APIProfile already set in config is ignored.
+func DetectAPIProfile(ctx context.Context, config ClientConfig) (*APIProfile, error) {
+	config.APIProfile = nil
+	client, err := NewClient(config)
+	if err != nil {
+		return nil, err
+	}
+	c := client.WithContext(ctx).(*pulsarClient)
+
+	legacy := false
+	version, err := c.brokerVersion(APIV2)
+	if IsNotFound(err) {
+		// brokers older than 2.0 only serve the v1 admin API
+		legacy = true
+		version, err = c.brokerVersion(APIV1)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("detecting broker version: %w", err)
+	}
+
+	major, minor, err := parseBrokerVersion(version)
+	if err != nil {
+		return nil, err
+	}
+
+	profile := defaultAPIProfile()
+	if legacy {
+		for _, r := range profile.resources() {
+			if containsAPIVersion(r.supported, APIV1) {
+				*r.version = APIV1
+			}
+		}
+	}
+	if major < 2 || (major == 2 && minor < 3) {
+		// the v3 functions API was introduced in 2.3.0
+		profile.Functions = APIV2
+	}
+	return profile, nil
+}
+
+func (c *pulsarClient) brokerVersion(apiVersion APIVersion) (string, error) {
+	endpoint := c.endpoint(apiVersion, "/brokers", "version")
+	body, err := c.restClient.WithOperation("DetectAPIProfile").GetWithQueryParams(endpoint, nil, nil, false)
+	if err != nil {
+		return "", err
+	}
+	return strings.Trim(strings.TrimSpace(string(body)), `"`), nil
+}
+
+// parseBrokerVersion extracts the major and minor components from a broker
+// version such as "2.10.1" or "3.0.0.1-SNAPSHOT".
+func parseBrokerVersion(version string) (int, int, error) {
+	parts := strings.SplitN(version, ".", 3)
+	if len(parts) < 2 {
+		return 0, 0, fmt.Errorf("invalid broker version %q", version)
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid broker version %q", version)
+	}
+	minor, err := strconv.Atoi(strings.TrimRightFunc(parts[1], func(r rune) bool {
+		return r < '0' || r > '9'
+	}))
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid broker version %q", version)
+	}
+	return major, minor, nil
+}
+
+func containsAPIVersion(versions []APIVersion, v APIVersion) bool {
+	for _, version := range versions {
+		if version == v {
+			return true
+		}
+	}
+	return false
+}
+
+func apiVersionName(v APIVersion) string {
+	switch v {
+	case APIV1:
+		return "V1"
+	case APIV2:
+		return "V2"
+	case APIV3:
+		return "V3"
+	}
+	return strconv.Itoa(int(v))
+}
+
+func apiVersionNames(versions []APIVersion) string {
+	names := make([]string, len(versions))
+	for i, v := range versions {
+		names[i] = apiVersionName(v)
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,104 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsaradmin
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewClientHonorsAPIProfile(t *testing.T) {
+	client, err := NewClient(ClientConfig{
+		APIProfile: &APIProfile{Functions: APIV2},
+	})
+	require.NoError(t, err)
+
+	profile := client.(*pulsarClient).apiProfile
+	assert.Equal(t, APIV2, profile.Functions)
+	assert.Equal(t, APIV3, profile.Packages)
+	assert.Equal(t, APIV2, profile.Topics)
+}
+
+func TestNewClientDefaultAPIProfile(t *testing.T) {
+	client, err := NewClient(ClientConfig{})
+	require.NoError(t, err)
+	assert.Equal(t, *defaultAPIProfile(), client.(*pulsarClient).apiProfile)
+}
+
+func TestNewClientRejectsUnsupportedAPIVersion(t *testing.T) {
+	_, err := NewClient(ClientConfig{
+		APIProfile: &APIProfile{Packages: APIV1},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Packages")
+}
+
+func TestAPIProfileValidate(t *testing.T) {
+	assert.NoError(t, APIProfile{}.Validate())
+	assert.NoError(t, defaultAPIProfile().Validate())
+	assert.Error(t, APIProfile{Sinks: APIV2}.Validate())
+	assert.Error(t, APIProfile{Tenants: APIV3}.Validate())
+}
+
+func TestDetectAPIProfile(t *testing.T) {
+	testcases := []struct {
+		version   string
+		functions APIVersion
+	}{
+		{version: "2.10.1", functions: APIV3},
+		{version: `"3.0.0.1-SNAPSHOT"`, functions: APIV3},
+		{version: "2.2.1", functions: APIV2},
+	}
+
+	for _, testcase := range testcases {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/admin/v2/brokers/version" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write([]byte(testcase.version))
+		}))
+
+		profile, err := DetectAPIProfile(context.Background(), ClientConfig{WebServiceURL: server.URL})
+		server.Close()
+		require.NoError(t, err)
+		assert.Equal(t, testcase.functions, profile.Functions)
+		assert.Equal(t, APIV2, profile.Topics)
+		assert.NoError(t, profile.Validate())
+	}
+}
+
+func TestDetectAPIProfileLegacyBroker(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/admin/brokers/version" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte("1.22.1-incubating"))
+	}))
+	defer server.Close()
+
+	profile, err := DetectAPIProfile(context.Background(), ClientConfig{WebServiceURL: server.URL})
+	require.NoError(t, err)
+	assert.Equal(t, APIV1, profile.Topics)
+	assert.Equal(t, APIV2, profile.Tenants)
+	assert.Equal(t, APIV2, profile.Functions)
+}
@@ -49,7 +49,7 @@ func (c *pulsarClient) ResourceQuotas() ResourceQuotas {
 func (r *resource) GetDefaultResourceQuota() (*ResourceQuota, error) {
 	endpoint := r.pulsar.endpoint(r.apiVersion, r.basePath)
 	var quota ResourceQuota
-	err := r.pulsar.restClient.Get(endpoint, &quota)
+	err := r.pulsar.restClient.WithOperation("ResourceQuotas.GetDefaultResourceQuota").Get(endpoint, &quota)
 	if err != nil {
 		return nil, err
 	}
@@ -58,13 +58,13 @@ func (r *resource) GetDefaultResourceQuota() (*ResourceQuota, error) {
 
 func (r *resource) SetDefaultResourceQuota(quota ResourceQuota) error {
 	endpoint := r.pulsar.endpoint(r.apiVersion, r.basePath)
-	return r.pulsar.restClient.Post(endpoint, &quota)
+	return r.pulsar.restClient.WithOperation("ResourceQuotas.SetDefaultResourceQuota").Post(endpoint, &quota)
 }
 
 func (r *resource) GetNamespaceBundleResourceQuota(namespace, bundle string) (*ResourceQuota, error) {
 	endpoint := r.pulsar.endpoint(r.apiVersion, r.basePath, namespace, bundle)
 	var quota ResourceQuota
-	err := r.pulsar.restClient.Get(endpoint, &quota)
+	err := r.pulsar.restClient.WithOperation("ResourceQuotas.GetNamespaceBundleResourceQuota").Get(endpoint, &quota)
 	if err != nil {
 		return nil, err
 	}
@@ -73,10 +73,10 @@ func (r *resource) GetNamespaceBundleResourceQuota(namespace, bundle string) (*R
 
 func (r *resource) SetNamespaceBundleResourceQuota(namespace, bundle string, quota ResourceQuota) error {
 	endpoint := r.pulsar.endpoint(r.apiVersion, r.basePath, namespace, bundle)
-	return r.pulsar.restClient.Post(endpoint, &quota)
+	return r.pulsar.restClient.WithOperation("ResourceQuotas.SetNamespaceBundleResourceQuota").Post(endpoint, &quota)
 }
 
 func (r *resource) ResetNamespaceBundleResourceQuota(namespace, bundle string) error {
 	endpoint := r.pulsar.endpoint(r.apiVersion, r.basePath, namespace, bundle)
-	return r.pulsar.restClient.Delete(endpoint)
+	return r.pulsar.restClient.WithOperation("ResourceQuotas.ResetNamespaceBundleResourceQuota").Delete(endpoint)
 }
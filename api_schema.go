@@ -17,6 +17,7 @@ package pulsaradmin
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
 )
 
@@ -36,6 +37,20 @@ type Schema interface {
 
 	// CreateSchemaByPayload creates a schema for a given <tt>topic</tt>
 	CreateSchemaByPayload(topic string, schemaPayload PostSchemaPayload) error
+
+	// CreateSchemaBySchemaInfo creates a schema for a given <tt>topic</tt> from a SchemaInfo,
+	// including a KEY_VALUE schema with its key and value schemas
+	CreateSchemaBySchemaInfo(topic string, schemaInfo SchemaInfo) error
+
+	// TestCompatibility checks whether a schema is compatible with the schemas of a given <tt>topic</tt>,
+	// under the compatibility strategy of the topic
+	TestCompatibility(topic string, schemaPayload PostSchemaPayload) (*IsCompatibilityResponse, error)
+
+	// GetAllSchemas retrieves all the schema versions of a given <tt>topic</tt>, oldest first
+	GetAllSchemas(topic string) ([]*SchemaInfoWithVersion, error)
+
+	// GetVersionBySchema retrieves the version of a schema of a given <tt>topic</tt>
+	GetVersionBySchema(topic string, schemaPayload PostSchemaPayload) (int64, error)
 }
 
 type schemas struct {
@@ -62,7 +77,7 @@ func (s *schemas) GetSchemaInfo(topic string) (*SchemaInfo, error) {
 	endpoint := s.pulsar.endpoint(s.apiVersion, s.basePath, topicName.GetTenant(), topicName.GetNamespace(),
 		topicName.GetLocalName(), "schema")
 
-	err = s.pulsar.restClient.Get(endpoint, &response)
+	err = s.pulsar.restClient.WithOperation("Schemas.GetSchemaInfo").Get(endpoint, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -80,7 +95,7 @@ func (s *schemas) GetSchemaInfoWithVersion(topic string) (*SchemaInfoWithVersion
 	endpoint := s.pulsar.endpoint(s.apiVersion, s.basePath, topicName.GetTenant(), topicName.GetNamespace(),
 		topicName.GetLocalName(), "schema")
 
-	err = s.pulsar.restClient.Get(endpoint, &response)
+	err = s.pulsar.restClient.WithOperation("Schemas.GetSchemaInfoWithVersion").Get(endpoint, &response)
 	if err != nil {
 		fmt.Println("err:", err.Error())
 		return nil, err
@@ -101,7 +116,7 @@ func (s *schemas) GetSchemaInfoByVersion(topic string, version int64) (*SchemaIn
 		topicName.GetLocalName(),
 		"schema", strconv.FormatInt(version, 10))
 
-	err = s.pulsar.restClient.Get(endpoint, &response)
+	err = s.pulsar.restClient.WithOperation("Schemas.GetSchemaInfoByVersion").Get(endpoint, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -121,7 +136,7 @@ func (s *schemas) DeleteSchema(topic string) error {
 
 	fmt.Println(endpoint)
 
-	return s.pulsar.restClient.Delete(endpoint)
+	return s.pulsar.restClient.WithOperation("Schemas.DeleteSchema").Delete(endpoint)
 }
 
 func (s *schemas) CreateSchemaByPayload(topic string, schemaPayload PostSchemaPayload) error {
@@ -133,5 +148,70 @@ func (s *schemas) CreateSchemaByPayload(topic string, schemaPayload PostSchemaPa
 	endpoint := s.pulsar.endpoint(s.apiVersion, s.basePath, topicName.GetTenant(), topicName.GetNamespace(),
 		topicName.GetLocalName(), "schema")
 
-	return s.pulsar.restClient.Post(endpoint, &schemaPayload)
+	return s.pulsar.restClient.WithOperation("Schemas.CreateSchemaByPayload").Post(endpoint, &schemaPayload)
+}
+
+func (s *schemas) CreateSchemaBySchemaInfo(topic string, schemaInfo SchemaInfo) error {
+	payload, err := ConvertSchemaInfoToPostSchemaPayload(schemaInfo)
+	if err != nil {
+		return err
+	}
+	return s.CreateSchemaByPayload(topic, payload)
+}
+
+func (s *schemas) TestCompatibility(topic string, schemaPayload PostSchemaPayload) (*IsCompatibilityResponse, error) {
+	topicName, err := GetTopicName(topic)
+	if err != nil {
+		return nil, err
+	}
+
+	var response IsCompatibilityResponse
+	endpoint := s.pulsar.endpoint(s.apiVersion, s.basePath, topicName.GetTenant(), topicName.GetNamespace(),
+		topicName.GetLocalName(), "compatibility")
+
+	err = s.pulsar.restClient.WithOperation("Schemas.TestCompatibility").PostWithObj(endpoint, &schemaPayload, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (s *schemas) GetAllSchemas(topic string) ([]*SchemaInfoWithVersion, error) {
+	topicName, err := GetTopicName(topic)
+	if err != nil {
+		return nil, err
+	}
+
+	var response GetAllVersionsSchemaResponse
+	endpoint := s.pulsar.endpoint(s.apiVersion, s.basePath, topicName.GetTenant(), topicName.GetNamespace(),
+		topicName.GetLocalName(), "schemas")
+
+	err = s.pulsar.restClient.WithOperation("Schemas.GetAllSchemas").Get(endpoint, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]*SchemaInfoWithVersion, 0, len(response.GetSchemaResponses))
+	for _, schema := range response.GetSchemaResponses {
+		infos = append(infos, ConvertGetSchemaResponseToSchemaInfoWithVersion(topicName, schema))
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Version < infos[j].Version })
+	return infos, nil
+}
+
+func (s *schemas) GetVersionBySchema(topic string, schemaPayload PostSchemaPayload) (int64, error) {
+	topicName, err := GetTopicName(topic)
+	if err != nil {
+		return 0, err
+	}
+
+	var response LongSchemaVersion
+	endpoint := s.pulsar.endpoint(s.apiVersion, s.basePath, topicName.GetTenant(), topicName.GetNamespace(),
+		topicName.GetLocalName(), "version")
+
+	err = s.pulsar.restClient.WithOperation("Schemas.GetVersionBySchema").PostWithObj(endpoint, &schemaPayload, &response)
+	if err != nil {
+		return 0, err
+	}
+	return response.Version, nil
 }
@@ -18,13 +18,14 @@ package pulsaradmin
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"mime/multipart"
-	"net/textproto"
-	"os"
 	"path/filepath"
 	"strings"
+
+	"github.com/streamnative/pulsar-admin-go/internal/rest"
 )
 
 // Sinks is admin interface for sinks management
@@ -38,12 +39,21 @@ type Sinks interface {
 	// CreateSink creates a new sink
 	CreateSink(config *SinkConfig, fileName string) error
 
+	// CreateSinkWithReader creates a new sink with the connector read from r, streamed as it is read and
+	// uploaded as fileName.
+	CreateSinkWithReader(config *SinkConfig, fileName string, r io.Reader, options *UploadOptions) error
+
 	// CreateSinkWithURL creates a new sink by providing url from which fun-pkg can be downloaded. supported url: http/file
 	CreateSinkWithURL(config *SinkConfig, pkgURL string) error
 
 	// UpdateSink updates the configuration for a sink.
 	UpdateSink(config *SinkConfig, fileName string, options *UpdateOptions) error
 
+	// UpdateSinkWithReader updates the configuration for a sink, with the connector read from r, streamed as
+	// it is read and uploaded as fileName.
+	UpdateSinkWithReader(config *SinkConfig, fileName string, r io.Reader, options *UpdateOptions,
+		uploadOptions *UploadOptions) error
+
 	// UpdateSinkWithURL updates a sink by providing url from which fun-pkg can be downloaded. supported url: http/file
 	UpdateSinkWithURL(config *SinkConfig, pkgURL string, options *UpdateOptions) error
 
@@ -96,89 +106,55 @@ func (c *pulsarClient) Sinks() Sinks {
 	}
 }
 
-func (s *sinks) createStringFromField(w *multipart.Writer, value string) (io.Writer, error) {
-	h := make(textproto.MIMEHeader)
-	h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s" `, value))
-	h.Set("Content-Type", "application/json")
-	return w.CreatePart(h)
-}
-
-func (s *sinks) createTextFromFiled(w *multipart.Writer, value string) (io.Writer, error) {
-	h := make(textproto.MIMEHeader)
-	h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s" `, value))
-	h.Set("Content-Type", "text/plain")
-	return w.CreatePart(h)
-}
-
 func (s *sinks) ListSinks(tenant, namespace string) ([]string, error) {
 	var sinks []string
 	endpoint := s.pulsar.endpoint(s.apiVersion, s.basePath, tenant, namespace)
-	err := s.pulsar.restClient.Get(endpoint, &sinks)
+	err := s.pulsar.restClient.WithOperation("Sinks.ListSinks").Get(endpoint, &sinks)
 	return sinks, err
 }
 
 func (s *sinks) GetSink(tenant, namespace, sink string) (SinkConfig, error) {
 	var sinkConfig SinkConfig
 	endpoint := s.pulsar.endpoint(s.apiVersion, s.basePath, tenant, namespace, sink)
-	err := s.pulsar.restClient.Get(endpoint, &sinkConfig)
+	err := s.pulsar.restClient.WithOperation("Sinks.GetSink").Get(endpoint, &sinkConfig)
 	return sinkConfig, err
 }
 
 func (s *sinks) CreateSink(config *SinkConfig, fileName string) error {
-	endpoint := s.pulsar.endpoint(s.apiVersion, s.basePath, config.Tenant, config.Namespace, config.Name)
-
-	// buffer to store our request as bytes
-	bodyBuf := bytes.NewBufferString("")
-
-	multiPartWriter := multipart.NewWriter(bodyBuf)
-	jsonData, err := json.Marshal(config)
-	if err != nil {
-		return err
+	if strings.HasPrefix(fileName, "builtin://") {
+		// If the sink code is built in, we don't need to submit here
+		fileName = ""
 	}
+	return uploadFile(fileName, func(name string, r io.Reader) error {
+		return s.createSink(s.pulsar.restClient.WithOperation("Sinks.CreateSink"), config, name, r, nil)
+	})
+}
 
-	stringWriter, err := s.createStringFromField(multiPartWriter, "sinkConfig")
-	if err != nil {
-		return err
+func (s *sinks) CreateSinkWithReader(config *SinkConfig, fileName string, r io.Reader, options *UploadOptions) error {
+	if r == nil {
+		return errors.New("reader is nil")
 	}
+	return s.createSink(s.pulsar.restClient.WithOperation("Sinks.CreateSinkWithReader"), config, fileName, r, options)
+}
 
-	_, err = stringWriter.Write(jsonData)
+func (s *sinks) createSink(client *rest.Client, config *SinkConfig, fileName string, r io.Reader,
+	options *UploadOptions,
+) error {
+	endpoint := s.pulsar.endpoint(s.apiVersion, s.basePath, config.Tenant, config.Namespace, config.Name)
+
+	jsonData, err := json.Marshal(config)
 	if err != nil {
 		return err
 	}
 
-	if fileName != "" && !strings.HasPrefix(fileName, "builtin://") {
-		// If the function code is built in, we don't need to submit here
-		file, err := os.Open(fileName)
-		if err != nil {
-			return err
-		}
-		defer file.Close()
-
-		part, err := multiPartWriter.CreateFormFile("data", filepath.Base(file.Name()))
-		if err != nil {
-			return err
-		}
-
-		// copy the actual file content to the filed's writer
-		_, err = io.Copy(part, file)
-		if err != nil {
+	upload := newMultipartUpload(options)
+	upload.addJSONField("sinkConfig", jsonData)
+	if r != nil {
+		if err := upload.setFile("data", filepath.Base(fileName), r); err != nil {
 			return err
 		}
 	}
-
-	// In here, we completed adding the file and the fields, let's close the multipart writer
-	// So it writes the ending boundary
-	if err = multiPartWriter.Close(); err != nil {
-		return err
-	}
-
-	contentType := multiPartWriter.FormDataContentType()
-	err = s.pulsar.restClient.PostWithMultiPart(endpoint, nil, bodyBuf, contentType)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return upload.post(client, endpoint)
 }
 
 func (s *sinks) CreateSinkWithURL(config *SinkConfig, pkgURL string) error {
@@ -188,7 +164,7 @@ func (s *sinks) CreateSinkWithURL(config *SinkConfig, pkgURL string) error {
 
 	multiPartWriter := multipart.NewWriter(bodyBuf)
 
-	textWriter, err := s.createTextFromFiled(multiPartWriter, "url")
+	textWriter, err := createFieldPart(multiPartWriter, "url", "text/plain")
 	if err != nil {
 		return err
 	}
@@ -203,7 +179,7 @@ func (s *sinks) CreateSinkWithURL(config *SinkConfig, pkgURL string) error {
 		return err
 	}
 
-	stringWriter, err := s.createStringFromField(multiPartWriter, "sinkConfig")
+	stringWriter, err := createFieldPart(multiPartWriter, "sinkConfig", "application/json")
 	if err != nil {
 		return err
 	}
@@ -218,7 +194,8 @@ func (s *sinks) CreateSinkWithURL(config *SinkConfig, pkgURL string) error {
 	}
 
 	contentType := multiPartWriter.FormDataContentType()
-	err = s.pulsar.restClient.PostWithMultiPart(endpoint, nil, bodyBuf, contentType)
+	client := s.pulsar.restClient.WithOperation("Sinks.CreateSinkWithURL")
+	err = client.PostWithMultiPart(endpoint, nil, bodyBuf, contentType)
 	if err != nil {
 		return err
 	}
@@ -227,77 +204,50 @@ func (s *sinks) CreateSinkWithURL(config *SinkConfig, pkgURL string) error {
 }
 
 func (s *sinks) UpdateSink(config *SinkConfig, fileName string, updateOptions *UpdateOptions) error {
-	endpoint := s.pulsar.endpoint(s.apiVersion, s.basePath, config.Tenant, config.Namespace, config.Name)
-	// buffer to store our request as bytes
-	bodyBuf := bytes.NewBufferString("")
-
-	multiPartWriter := multipart.NewWriter(bodyBuf)
-
-	jsonData, err := json.Marshal(config)
-	if err != nil {
-		return err
+	if strings.HasPrefix(fileName, "builtin://") {
+		// If the sink code is built in, we don't need to submit here
+		fileName = ""
 	}
+	return uploadFile(fileName, func(name string, r io.Reader) error {
+		return s.updateSink(s.pulsar.restClient.WithOperation("Sinks.UpdateSink"), config, name, r, updateOptions, nil)
+	})
+}
 
-	stringWriter, err := s.createStringFromField(multiPartWriter, "sinkConfig")
-	if err != nil {
-		return err
+func (s *sinks) UpdateSinkWithReader(config *SinkConfig, fileName string, r io.Reader, updateOptions *UpdateOptions,
+	options *UploadOptions,
+) error {
+	if r == nil {
+		return errors.New("reader is nil")
 	}
+	client := s.pulsar.restClient.WithOperation("Sinks.UpdateSinkWithReader")
+	return s.updateSink(client, config, fileName, r, updateOptions, options)
+}
 
-	_, err = stringWriter.Write(jsonData)
+func (s *sinks) updateSink(client *rest.Client, config *SinkConfig, fileName string, r io.Reader,
+	updateOptions *UpdateOptions, options *UploadOptions,
+) error {
+	endpoint := s.pulsar.endpoint(s.apiVersion, s.basePath, config.Tenant, config.Namespace, config.Name)
+
+	jsonData, err := json.Marshal(config)
 	if err != nil {
 		return err
 	}
 
+	upload := newMultipartUpload(options)
+	upload.addJSONField("sinkConfig", jsonData)
 	if updateOptions != nil {
 		updateData, err := json.Marshal(updateOptions)
 		if err != nil {
 			return err
 		}
-
-		updateStrWriter, err := s.createStringFromField(multiPartWriter, "updateOptions")
-		if err != nil {
-			return err
-		}
-
-		_, err = updateStrWriter.Write(updateData)
-		if err != nil {
-			return err
-		}
+		upload.addJSONField("updateOptions", updateData)
 	}
-
-	if fileName != "" && !strings.HasPrefix(fileName, "builtin://") {
-		// If the function code is built in, we don't need to submit here
-		file, err := os.Open(fileName)
-		if err != nil {
-			return err
-		}
-		defer file.Close()
-
-		part, err := multiPartWriter.CreateFormFile("data", filepath.Base(file.Name()))
-		if err != nil {
-			return err
-		}
-
-		// copy the actual file content to the filed's writer
-		_, err = io.Copy(part, file)
-		if err != nil {
+	if r != nil {
+		if err := upload.setFile("data", filepath.Base(fileName), r); err != nil {
 			return err
 		}
 	}
-
-	// In here, we completed adding the file and the fields, let's close the multipart writer
-	// So it writes the ending boundary
-	if err = multiPartWriter.Close(); err != nil {
-		return err
-	}
-
-	contentType := multiPartWriter.FormDataContentType()
-	err = s.pulsar.restClient.PutWithMultiPart(endpoint, bodyBuf, contentType)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return upload.put(client, endpoint)
 }
 
 func (s *sinks) UpdateSinkWithURL(config *SinkConfig, pkgURL string, updateOptions *UpdateOptions) error {
@@ -307,7 +257,7 @@ func (s *sinks) UpdateSinkWithURL(config *SinkConfig, pkgURL string, updateOptio
 
 	multiPartWriter := multipart.NewWriter(bodyBuf)
 
-	textWriter, err := s.createTextFromFiled(multiPartWriter, "url")
+	textWriter, err := createFieldPart(multiPartWriter, "url", "text/plain")
 	if err != nil {
 		return err
 	}
@@ -322,7 +272,7 @@ func (s *sinks) UpdateSinkWithURL(config *SinkConfig, pkgURL string, updateOptio
 		return err
 	}
 
-	stringWriter, err := s.createStringFromField(multiPartWriter, "sinkConfig")
+	stringWriter, err := createFieldPart(multiPartWriter, "sinkConfig", "application/json")
 	if err != nil {
 		return err
 	}
@@ -338,7 +288,7 @@ func (s *sinks) UpdateSinkWithURL(config *SinkConfig, pkgURL string, updateOptio
 			return err
 		}
 
-		updateStrWriter, err := s.createStringFromField(multiPartWriter, "updateOptions")
+		updateStrWriter, err := createFieldPart(multiPartWriter, "updateOptions", "application/json")
 		if err != nil {
 			return err
 		}
@@ -356,7 +306,7 @@ func (s *sinks) UpdateSinkWithURL(config *SinkConfig, pkgURL string, updateOptio
 	}
 
 	contentType := multiPartWriter.FormDataContentType()
-	err = s.pulsar.restClient.PutWithMultiPart(endpoint, bodyBuf, contentType)
+	err = s.pulsar.restClient.WithOperation("Sinks.UpdateSinkWithURL").PutWithMultiPart(endpoint, bodyBuf, contentType)
 	if err != nil {
 		return err
 	}
@@ -366,13 +316,13 @@ func (s *sinks) UpdateSinkWithURL(config *SinkConfig, pkgURL string, updateOptio
 
 func (s *sinks) DeleteSink(tenant, namespace, sink string) error {
 	endpoint := s.pulsar.endpoint(s.apiVersion, s.basePath, tenant, namespace, sink)
-	return s.pulsar.restClient.Delete(endpoint)
+	return s.pulsar.restClient.WithOperation("Sinks.DeleteSink").Delete(endpoint)
 }
 
 func (s *sinks) GetSinkStatus(tenant, namespace, sink string) (SinkStatus, error) {
 	var sinkStatus SinkStatus
 	endpoint := s.pulsar.endpoint(s.apiVersion, s.basePath, tenant, namespace, sink)
-	err := s.pulsar.restClient.Get(endpoint+"/status", &sinkStatus)
+	err := s.pulsar.restClient.WithOperation("Sinks.GetSinkStatus").Get(endpoint+"/status", &sinkStatus)
 	return sinkStatus, err
 }
 
@@ -380,54 +330,54 @@ func (s *sinks) GetSinkStatusWithID(tenant, namespace, sink string, id int) (Sin
 	var sinkInstanceStatusData SinkInstanceStatusData
 	instanceID := fmt.Sprintf("%d", id)
 	endpoint := s.pulsar.endpoint(s.apiVersion, s.basePath, tenant, namespace, sink, instanceID)
-	err := s.pulsar.restClient.Get(endpoint+"/status", &sinkInstanceStatusData)
+	err := s.pulsar.restClient.WithOperation("Sinks.GetSinkStatusWithID").Get(endpoint+"/status", &sinkInstanceStatusData)
 	return sinkInstanceStatusData, err
 }
 
 func (s *sinks) RestartSink(tenant, namespace, sink string) error {
 	endpoint := s.pulsar.endpoint(s.apiVersion, s.basePath, tenant, namespace, sink)
-	return s.pulsar.restClient.Post(endpoint+"/restart", nil)
+	return s.pulsar.restClient.WithOperation("Sinks.RestartSink").Post(endpoint+"/restart", nil)
 }
 
 func (s *sinks) RestartSinkWithID(tenant, namespace, sink string, instanceID int) error {
 	id := fmt.Sprintf("%d", instanceID)
 	endpoint := s.pulsar.endpoint(s.apiVersion, s.basePath, tenant, namespace, sink, id)
 
-	return s.pulsar.restClient.Post(endpoint+"/restart", nil)
+	return s.pulsar.restClient.WithOperation("Sinks.RestartSinkWithID").Post(endpoint+"/restart", nil)
 }
 
 func (s *sinks) StopSink(tenant, namespace, sink string) error {
 	endpoint := s.pulsar.endpoint(s.apiVersion, s.basePath, tenant, namespace, sink)
-	return s.pulsar.restClient.Post(endpoint+"/stop", nil)
+	return s.pulsar.restClient.WithOperation("Sinks.StopSink").Post(endpoint+"/stop", nil)
 }
 
 func (s *sinks) StopSinkWithID(tenant, namespace, sink string, instanceID int) error {
 	id := fmt.Sprintf("%d", instanceID)
 	endpoint := s.pulsar.endpoint(s.apiVersion, s.basePath, tenant, namespace, sink, id)
 
-	return s.pulsar.restClient.Post(endpoint+"/stop", nil)
+	return s.pulsar.restClient.WithOperation("Sinks.StopSinkWithID").Post(endpoint+"/stop", nil)
 }
 
 func (s *sinks) StartSink(tenant, namespace, sink string) error {
 	endpoint := s.pulsar.endpoint(s.apiVersion, s.basePath, tenant, namespace, sink)
-	return s.pulsar.restClient.Post(endpoint+"/start", nil)
+	return s.pulsar.restClient.WithOperation("Sinks.StartSink").Post(endpoint+"/start", nil)
 }
 
 func (s *sinks) StartSinkWithID(tenant, namespace, sink string, instanceID int) error {
 	id := fmt.Sprintf("%d", instanceID)
 	endpoint := s.pulsar.endpoint(s.apiVersion, s.basePath, tenant, namespace, sink, id)
 
-	return s.pulsar.restClient.Post(endpoint+"/start", nil)
+	return s.pulsar.restClient.WithOperation("Sinks.StartSinkWithID").Post(endpoint+"/start", nil)
 }
 
 func (s *sinks) GetBuiltInSinks() ([]*ConnectorDefinition, error) {
 	var connectorDefinition []*ConnectorDefinition
 	endpoint := s.pulsar.endpoint(s.apiVersion, s.basePath, "builtinsinks")
-	err := s.pulsar.restClient.Get(endpoint, &connectorDefinition)
+	err := s.pulsar.restClient.WithOperation("Sinks.GetBuiltInSinks").Get(endpoint, &connectorDefinition)
 	return connectorDefinition, err
 }
 
 func (s *sinks) ReloadBuiltInSinks() error {
 	endpoint := s.pulsar.endpoint(s.apiVersion, s.basePath, "reloadBuiltInSinks")
-	return s.pulsar.restClient.Post(endpoint, nil)
+	return s.pulsar.restClient.WithOperation("Sinks.ReloadBuiltInSinks").Post(endpoint, nil)
 }
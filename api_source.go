@@ -18,13 +18,14 @@ package pulsaradmin
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"mime/multipart"
-	"net/textproto"
-	"os"
 	"path/filepath"
 	"strings"
+
+	"github.com/streamnative/pulsar-admin-go/internal/rest"
 )
 
 // Sources is admin interface for sources management
@@ -38,6 +39,10 @@ type Sources interface {
 	// CreateSource creates a new source
 	CreateSource(config *SourceConfig, fileName string) error
 
+	// CreateSourceWithReader creates a new source with the connector read from r, streamed as it is read and
+	// uploaded as fileName.
+	CreateSourceWithReader(config *SourceConfig, fileName string, r io.Reader, options *UploadOptions) error
+
 	// CreateSourceWithURL creates a new source by providing url from which fun-pkg can be downloaded.
 	// supported url: http/file
 	CreateSourceWithURL(config *SourceConfig, pkgURL string) error
@@ -45,6 +50,11 @@ type Sources interface {
 	// UpdateSource updates the configuration for a source.
 	UpdateSource(config *SourceConfig, fileName string, options *UpdateOptions) error
 
+	// UpdateSourceWithReader updates the configuration for a source, with the connector read from r,
+	// streamed as it is read and uploaded as fileName.
+	UpdateSourceWithReader(config *SourceConfig, fileName string, r io.Reader, options *UpdateOptions,
+		uploadOptions *UploadOptions) error
+
 	// UpdateSourceWithURL updates a source by providing url from which fun-pkg can be downloaded. supported url: http/file
 	UpdateSourceWithURL(config *SourceConfig, pkgURL string, options *UpdateOptions) error
 
@@ -97,89 +107,58 @@ func (c *pulsarClient) Sources() Sources {
 	}
 }
 
-func (s *sources) createStringFromField(w *multipart.Writer, value string) (io.Writer, error) {
-	h := make(textproto.MIMEHeader)
-	h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s" `, value))
-	h.Set("Content-Type", "application/json")
-	return w.CreatePart(h)
-}
-
-func (s *sources) createTextFromFiled(w *multipart.Writer, value string) (io.Writer, error) {
-	h := make(textproto.MIMEHeader)
-	h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s" `, value))
-	h.Set("Content-Type", "text/plain")
-	return w.CreatePart(h)
-}
-
 func (s *sources) ListSources(tenant, namespace string) ([]string, error) {
 	var sources []string
 	endpoint := s.pulsar.endpoint(s.apiVersion, s.basePath, tenant, namespace)
-	err := s.pulsar.restClient.Get(endpoint, &sources)
+	err := s.pulsar.restClient.WithOperation("Sources.ListSources").Get(endpoint, &sources)
 	return sources, err
 }
 
 func (s *sources) GetSource(tenant, namespace, source string) (SourceConfig, error) {
 	var sourceConfig SourceConfig
 	endpoint := s.pulsar.endpoint(s.apiVersion, s.basePath, tenant, namespace, source)
-	err := s.pulsar.restClient.Get(endpoint, &sourceConfig)
+	err := s.pulsar.restClient.WithOperation("Sources.GetSource").Get(endpoint, &sourceConfig)
 	return sourceConfig, err
 }
 
 func (s *sources) CreateSource(config *SourceConfig, fileName string) error {
-	endpoint := s.pulsar.endpoint(s.apiVersion, s.basePath, config.Tenant, config.Namespace, config.Name)
-
-	// buffer to store our request as bytes
-	bodyBuf := bytes.NewBufferString("")
-
-	multiPartWriter := multipart.NewWriter(bodyBuf)
-	jsonData, err := json.Marshal(config)
-	if err != nil {
-		return err
+	if strings.HasPrefix(fileName, "builtin://") {
+		// If the source code is built in, we don't need to submit here
+		fileName = ""
 	}
+	return uploadFile(fileName, func(name string, r io.Reader) error {
+		return s.createSource(s.pulsar.restClient.WithOperation("Sources.CreateSource"), config, name, r, nil)
+	})
+}
 
-	stringWriter, err := s.createStringFromField(multiPartWriter, "sourceConfig")
-	if err != nil {
-		return err
+func (s *sources) CreateSourceWithReader(config *SourceConfig, fileName string, r io.Reader,
+	options *UploadOptions,
+) error {
+	if r == nil {
+		return errors.New("reader is nil")
 	}
+	client := s.pulsar.restClient.WithOperation("Sources.CreateSourceWithReader")
+	return s.createSource(client, config, fileName, r, options)
+}
 
-	_, err = stringWriter.Write(jsonData)
+func (s *sources) createSource(client *rest.Client, config *SourceConfig, fileName string, r io.Reader,
+	options *UploadOptions,
+) error {
+	endpoint := s.pulsar.endpoint(s.apiVersion, s.basePath, config.Tenant, config.Namespace, config.Name)
+
+	jsonData, err := json.Marshal(config)
 	if err != nil {
 		return err
 	}
 
-	if fileName != "" && !strings.HasPrefix(fileName, "builtin://") {
-		// If the function code is built in, we don't need to submit here
-		file, err := os.Open(fileName)
-		if err != nil {
-			return err
-		}
-		defer file.Close()
-
-		part, err := multiPartWriter.CreateFormFile("data", filepath.Base(file.Name()))
-		if err != nil {
-			return err
-		}
-
-		// copy the actual file content to the filed's writer
-		_, err = io.Copy(part, file)
-		if err != nil {
+	upload := newMultipartUpload(options)
+	upload.addJSONField("sourceConfig", jsonData)
+	if r != nil {
+		if err := upload.setFile("data", filepath.Base(fileName), r); err != nil {
 			return err
 		}
 	}
-
-	// In here, we completed adding the file and the fields, let's close the multipart writer
-	// So it writes the ending boundary
-	if err = multiPartWriter.Close(); err != nil {
-		return err
-	}
-
-	contentType := multiPartWriter.FormDataContentType()
-	err = s.pulsar.restClient.PostWithMultiPart(endpoint, nil, bodyBuf, contentType)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return upload.post(client, endpoint)
 }
 
 func (s *sources) CreateSourceWithURL(config *SourceConfig, pkgURL string) error {
@@ -189,7 +168,7 @@ func (s *sources) CreateSourceWithURL(config *SourceConfig, pkgURL string) error
 
 	multiPartWriter := multipart.NewWriter(bodyBuf)
 
-	textWriter, err := s.createTextFromFiled(multiPartWriter, "url")
+	textWriter, err := createFieldPart(multiPartWriter, "url", "text/plain")
 	if err != nil {
 		return err
 	}
@@ -204,7 +183,7 @@ func (s *sources) CreateSourceWithURL(config *SourceConfig, pkgURL string) error
 		return err
 	}
 
-	stringWriter, err := s.createStringFromField(multiPartWriter, "sourceConfig")
+	stringWriter, err := createFieldPart(multiPartWriter, "sourceConfig", "application/json")
 	if err != nil {
 		return err
 	}
@@ -219,7 +198,8 @@ func (s *sources) CreateSourceWithURL(config *SourceConfig, pkgURL string) error
 	}
 
 	contentType := multiPartWriter.FormDataContentType()
-	err = s.pulsar.restClient.PostWithMultiPart(endpoint, nil, bodyBuf, contentType)
+	client := s.pulsar.restClient.WithOperation("Sources.CreateSourceWithURL")
+	err = client.PostWithMultiPart(endpoint, nil, bodyBuf, contentType)
 	if err != nil {
 		return err
 	}
@@ -228,77 +208,50 @@ func (s *sources) CreateSourceWithURL(config *SourceConfig, pkgURL string) error
 }
 
 func (s *sources) UpdateSource(config *SourceConfig, fileName string, updateOptions *UpdateOptions) error {
-	endpoint := s.pulsar.endpoint(s.apiVersion, s.basePath, config.Tenant, config.Namespace, config.Name)
-	// buffer to store our request as bytes
-	bodyBuf := bytes.NewBufferString("")
-
-	multiPartWriter := multipart.NewWriter(bodyBuf)
-
-	jsonData, err := json.Marshal(config)
-	if err != nil {
-		return err
+	if strings.HasPrefix(fileName, "builtin://") {
+		// If the source code is built in, we don't need to submit here
+		fileName = ""
 	}
+	return uploadFile(fileName, func(name string, r io.Reader) error {
+		return s.updateSource(s.pulsar.restClient.WithOperation("Sources.UpdateSource"), config, name, r, updateOptions, nil)
+	})
+}
 
-	stringWriter, err := s.createStringFromField(multiPartWriter, "sourceConfig")
-	if err != nil {
-		return err
+func (s *sources) UpdateSourceWithReader(config *SourceConfig, fileName string, r io.Reader,
+	updateOptions *UpdateOptions, options *UploadOptions,
+) error {
+	if r == nil {
+		return errors.New("reader is nil")
 	}
+	client := s.pulsar.restClient.WithOperation("Sources.UpdateSourceWithReader")
+	return s.updateSource(client, config, fileName, r, updateOptions, options)
+}
 
-	_, err = stringWriter.Write(jsonData)
+func (s *sources) updateSource(client *rest.Client, config *SourceConfig, fileName string, r io.Reader,
+	updateOptions *UpdateOptions, options *UploadOptions,
+) error {
+	endpoint := s.pulsar.endpoint(s.apiVersion, s.basePath, config.Tenant, config.Namespace, config.Name)
+
+	jsonData, err := json.Marshal(config)
 	if err != nil {
 		return err
 	}
 
+	upload := newMultipartUpload(options)
+	upload.addJSONField("sourceConfig", jsonData)
 	if updateOptions != nil {
 		updateData, err := json.Marshal(updateOptions)
 		if err != nil {
 			return err
 		}
-
-		updateStrWriter, err := s.createStringFromField(multiPartWriter, "updateOptions")
-		if err != nil {
-			return err
-		}
-
-		_, err = updateStrWriter.Write(updateData)
-		if err != nil {
-			return err
-		}
+		upload.addJSONField("updateOptions", updateData)
 	}
-
-	if fileName != "" && !strings.HasPrefix(fileName, "builtin://") {
-		// If the function code is built in, we don't need to submit here
-		file, err := os.Open(fileName)
-		if err != nil {
-			return err
-		}
-		defer file.Close()
-
-		part, err := multiPartWriter.CreateFormFile("data", filepath.Base(file.Name()))
-		if err != nil {
-			return err
-		}
-
-		// copy the actual file content to the filed's writer
-		_, err = io.Copy(part, file)
-		if err != nil {
+	if r != nil {
+		if err := upload.setFile("data", filepath.Base(fileName), r); err != nil {
 			return err
 		}
 	}
-
-	// In here, we completed adding the file and the fields, let's close the multipart writer
-	// So it writes the ending boundary
-	if err = multiPartWriter.Close(); err != nil {
-		return err
-	}
-
-	contentType := multiPartWriter.FormDataContentType()
-	err = s.pulsar.restClient.PutWithMultiPart(endpoint, bodyBuf, contentType)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return upload.put(client, endpoint)
 }
 
 func (s *sources) UpdateSourceWithURL(config *SourceConfig, pkgURL string,
@@ -310,7 +263,7 @@ func (s *sources) UpdateSourceWithURL(config *SourceConfig, pkgURL string,
 
 	multiPartWriter := multipart.NewWriter(bodyBuf)
 
-	textWriter, err := s.createTextFromFiled(multiPartWriter, "url")
+	textWriter, err := createFieldPart(multiPartWriter, "url", "text/plain")
 	if err != nil {
 		return err
 	}
@@ -325,7 +278,7 @@ func (s *sources) UpdateSourceWithURL(config *SourceConfig, pkgURL string,
 		return err
 	}
 
-	stringWriter, err := s.createStringFromField(multiPartWriter, "sourceConfig")
+	stringWriter, err := createFieldPart(multiPartWriter, "sourceConfig", "application/json")
 	if err != nil {
 		return err
 	}
@@ -341,7 +294,7 @@ func (s *sources) UpdateSourceWithURL(config *SourceConfig, pkgURL string,
 			return err
 		}
 
-		updateStrWriter, err := s.createStringFromField(multiPartWriter, "updateOptions")
+		updateStrWriter, err := createFieldPart(multiPartWriter, "updateOptions", "application/json")
 		if err != nil {
 			return err
 		}
@@ -359,7 +312,7 @@ func (s *sources) UpdateSourceWithURL(config *SourceConfig, pkgURL string,
 	}
 
 	contentType := multiPartWriter.FormDataContentType()
-	err = s.pulsar.restClient.PutWithMultiPart(endpoint, bodyBuf, contentType)
+	err = s.pulsar.restClient.WithOperation("Sources.UpdateSourceWithURL").PutWithMultiPart(endpoint, bodyBuf, contentType)
 	if err != nil {
 		return err
 	}
@@ -369,13 +322,13 @@ func (s *sources) UpdateSourceWithURL(config *SourceConfig, pkgURL string,
 
 func (s *sources) DeleteSource(tenant, namespace, source string) error {
 	endpoint := s.pulsar.endpoint(s.apiVersion, s.basePath, tenant, namespace, source)
-	return s.pulsar.restClient.Delete(endpoint)
+	return s.pulsar.restClient.WithOperation("Sources.DeleteSource").Delete(endpoint)
 }
 
 func (s *sources) GetSourceStatus(tenant, namespace, source string) (SourceStatus, error) {
 	var sourceStatus SourceStatus
 	endpoint := s.pulsar.endpoint(s.apiVersion, s.basePath, tenant, namespace, source)
-	err := s.pulsar.restClient.Get(endpoint+"/status", &sourceStatus)
+	err := s.pulsar.restClient.WithOperation("Sources.GetSourceStatus").Get(endpoint+"/status", &sourceStatus)
 	return sourceStatus, err
 }
 
@@ -385,54 +338,55 @@ func (s *sources) GetSourceStatusWithID(tenant, namespace, source string, id int
 	var sourceInstanceStatusData SourceInstanceStatusData
 	instanceID := fmt.Sprintf("%d", id)
 	endpoint := s.pulsar.endpoint(s.apiVersion, s.basePath, tenant, namespace, source, instanceID)
-	err := s.pulsar.restClient.Get(endpoint+"/status", &sourceInstanceStatusData)
+	client := s.pulsar.restClient.WithOperation("Sources.GetSourceStatusWithID")
+	err := client.Get(endpoint+"/status", &sourceInstanceStatusData)
 	return sourceInstanceStatusData, err
 }
 
 func (s *sources) RestartSource(tenant, namespace, source string) error {
 	endpoint := s.pulsar.endpoint(s.apiVersion, s.basePath, tenant, namespace, source)
-	return s.pulsar.restClient.Post(endpoint+"/restart", nil)
+	return s.pulsar.restClient.WithOperation("Sources.RestartSource").Post(endpoint+"/restart", nil)
 }
 
 func (s *sources) RestartSourceWithID(tenant, namespace, source string, instanceID int) error {
 	id := fmt.Sprintf("%d", instanceID)
 	endpoint := s.pulsar.endpoint(s.apiVersion, s.basePath, tenant, namespace, source, id)
 
-	return s.pulsar.restClient.Post(endpoint+"/restart", nil)
+	return s.pulsar.restClient.WithOperation("Sources.RestartSourceWithID").Post(endpoint+"/restart", nil)
 }
 
 func (s *sources) StopSource(tenant, namespace, source string) error {
 	endpoint := s.pulsar.endpoint(s.apiVersion, s.basePath, tenant, namespace, source)
-	return s.pulsar.restClient.Post(endpoint+"/stop", nil)
+	return s.pulsar.restClient.WithOperation("Sources.StopSource").Post(endpoint+"/stop", nil)
 }
 
 func (s *sources) StopSourceWithID(tenant, namespace, source string, instanceID int) error {
 	id := fmt.Sprintf("%d", instanceID)
 	endpoint := s.pulsar.endpoint(s.apiVersion, s.basePath, tenant, namespace, source, id)
 
-	return s.pulsar.restClient.Post(endpoint+"/stop", nil)
+	return s.pulsar.restClient.WithOperation("Sources.StopSourceWithID").Post(endpoint+"/stop", nil)
 }
 
 func (s *sources) StartSource(tenant, namespace, source string) error {
 	endpoint := s.pulsar.endpoint(s.apiVersion, s.basePath, tenant, namespace, source)
-	return s.pulsar.restClient.Post(endpoint+"/start", nil)
+	return s.pulsar.restClient.WithOperation("Sources.StartSource").Post(endpoint+"/start", nil)
 }
 
 func (s *sources) StartSourceWithID(tenant, namespace, source string, instanceID int) error {
 	id := fmt.Sprintf("%d", instanceID)
 	endpoint := s.pulsar.endpoint(s.apiVersion, s.basePath, tenant, namespace, source, id)
 
-	return s.pulsar.restClient.Post(endpoint+"/start", nil)
+	return s.pulsar.restClient.WithOperation("Sources.StartSourceWithID").Post(endpoint+"/start", nil)
 }
 
 func (s *sources) GetBuiltInSources() ([]*ConnectorDefinition, error) {
 	var connectorDefinition []*ConnectorDefinition
 	endpoint := s.pulsar.endpoint(s.apiVersion, s.basePath, "builtinsources")
-	err := s.pulsar.restClient.Get(endpoint, &connectorDefinition)
+	err := s.pulsar.restClient.WithOperation("Sources.GetBuiltInSources").Get(endpoint, &connectorDefinition)
 	return connectorDefinition, err
 }
 
 func (s *sources) ReloadBuiltInSources() error {
 	endpoint := s.pulsar.endpoint(s.apiVersion, s.basePath, "reloadBuiltInSources")
-	return s.pulsar.restClient.Post(endpoint, nil)
+	return s.pulsar.restClient.WithOperation("Sources.ReloadBuiltInSources").Post(endpoint, nil)
 }
@@ -17,14 +17,21 @@ package pulsaradmin
 
 import (
 	"bytes"
+	"encoding/base64"
 	"encoding/binary"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/golang/protobuf/proto" //nolint:staticcheck
+
+	"github.com/streamnative/pulsar-admin-go/internal/compression"
+	"github.com/streamnative/pulsar-admin-go/internal/rest"
 )
 
 // Subscriptions is admin interface for subscriptions management
@@ -70,6 +77,25 @@ type Subscriptions interface {
 
 	// GetMessageByID gets message by its ledgerID and entryID
 	GetMessageByID(topic TopicName, ledgerID, entryID int64) (*Message, error)
+
+	// PeekMessagesWithOptions peeks messages from a topic subscription, with the given options
+	PeekMessagesWithOptions(topic TopicName, sName string, n int, options *PeekOptions) ([]*Message, error)
+
+	// GetMessageByIDWithOptions gets message by its ledgerID and entryID, with the given options
+	GetMessageByIDWithOptions(topic TopicName, ledgerID, entryID int64, options *PeekOptions) (*Message, error)
+
+	// GetMessagesByID gets all the messages of an entry, one per message of a batch, by its ledgerID and entryID
+	GetMessagesByID(topic TopicName, ledgerID, entryID int64, options *PeekOptions) ([]*Message, error)
+}
+
+// PeekOptions are the options of the peek calls.
+type PeekOptions struct {
+	// ReassembleChunks returns the chunks of a chunked message as a single
+	// message, fetching the chunks that follow the first one by ledger and
+	// entry, in the following ledgers of the topic if needed. The other
+	// chunks of a reassembled message are not returned; a chunk whose first
+	// chunk was not read is returned as it is.
+	ReassembleChunks bool
 }
 
 type subscriptions struct {
@@ -91,92 +117,121 @@ func (c *pulsarClient) Subscriptions() Subscriptions {
 
 func (s *subscriptions) Create(topic TopicName, sName string, messageID MessageID) error {
 	endpoint := s.pulsar.endpoint(s.topicAPI, s.basePath, topic.GetRestPath(), s.SubPath, url.PathEscape(sName))
-	return s.pulsar.restClient.Put(endpoint, messageID)
+	return s.pulsar.restClient.WithOperation("Subscriptions.Create").Put(endpoint, messageID)
 }
 
-func (s *subscriptions) delete(topic TopicName, subName string, force bool) error {
+func (s *subscriptions) delete(client *rest.Client, topic TopicName, subName string, force bool) error {
 	endpoint := s.pulsar.endpoint(s.topicAPI, s.basePath, topic.GetRestPath(), s.SubPath, url.PathEscape(subName))
 	queryParams := make(map[string]string)
 	queryParams["force"] = strconv.FormatBool(force)
-	return s.pulsar.restClient.DeleteWithQueryParams(endpoint, queryParams)
+	return client.DeleteWithQueryParams(endpoint, queryParams)
 }
 
 func (s *subscriptions) Delete(topic TopicName, sName string) error {
-	return s.delete(topic, sName, false)
+	return s.delete(s.pulsar.restClient.WithOperation("Subscriptions.Delete"), topic, sName, false)
 }
 
 func (s *subscriptions) ForceDelete(topic TopicName, sName string) error {
-	return s.delete(topic, sName, true)
+	return s.delete(s.pulsar.restClient.WithOperation("Subscriptions.ForceDelete"), topic, sName, true)
 }
 
 func (s *subscriptions) List(topic TopicName) ([]string, error) {
 	endpoint := s.pulsar.endpoint(s.topicAPI, s.basePath, topic.GetRestPath(), "subscriptions")
 	var list []string
-	return list, s.pulsar.restClient.Get(endpoint, &list)
+	return list, s.pulsar.restClient.WithOperation("Subscriptions.List").Get(endpoint, &list)
 }
 
 func (s *subscriptions) ResetCursorToMessageID(topic TopicName, sName string, id MessageID) error {
 	endpoint := s.pulsar.endpoint(s.topicAPI, s.basePath, topic.GetRestPath(), s.SubPath, url.PathEscape(sName),
 		"resetcursor")
-	return s.pulsar.restClient.Post(endpoint, id)
+	return s.pulsar.restClient.WithOperation("Subscriptions.ResetCursorToMessageID").Post(endpoint, id)
 }
 
 func (s *subscriptions) ResetCursorToTimestamp(topic TopicName, sName string, timestamp int64) error {
 	endpoint := s.pulsar.endpoint(s.topicAPI,
 		s.basePath, topic.GetRestPath(), s.SubPath, url.PathEscape(sName),
 		"resetcursor", strconv.FormatInt(timestamp, 10))
-	return s.pulsar.restClient.Post(endpoint, nil)
+	return s.pulsar.restClient.WithOperation("Subscriptions.ResetCursorToTimestamp").Post(endpoint, nil)
 }
 
 func (s *subscriptions) ClearBacklog(topic TopicName, sName string) error {
 	endpoint := s.pulsar.endpoint(s.topicAPI,
 		s.basePath, topic.GetRestPath(), s.SubPath, url.PathEscape(sName), "skip_all")
-	return s.pulsar.restClient.Post(endpoint, nil)
+	return s.pulsar.restClient.WithOperation("Subscriptions.ClearBacklog").Post(endpoint, nil)
 }
 
 func (s *subscriptions) SkipMessages(topic TopicName, sName string, n int64) error {
 	endpoint := s.pulsar.endpoint(s.topicAPI,
 		s.basePath, topic.GetRestPath(), s.SubPath, url.PathEscape(sName),
 		"skip", strconv.FormatInt(n, 10))
-	return s.pulsar.restClient.Post(endpoint, nil)
+	return s.pulsar.restClient.WithOperation("Subscriptions.SkipMessages").Post(endpoint, nil)
 }
 
 func (s *subscriptions) ExpireMessages(topic TopicName, sName string, expire int64) error {
 	endpoint := s.pulsar.endpoint(s.topicAPI,
 		s.basePath, topic.GetRestPath(), s.SubPath, url.PathEscape(sName),
 		"expireMessages", strconv.FormatInt(expire, 10))
-	return s.pulsar.restClient.Post(endpoint, nil)
+	return s.pulsar.restClient.WithOperation("Subscriptions.ExpireMessages").Post(endpoint, nil)
 }
 
 func (s *subscriptions) ExpireAllMessages(topic TopicName, expire int64) error {
 	endpoint := s.pulsar.endpoint(s.topicAPI,
 		s.basePath, topic.GetRestPath(), "all_subscription",
 		"expireMessages", strconv.FormatInt(expire, 10))
-	return s.pulsar.restClient.Post(endpoint, nil)
+	return s.pulsar.restClient.WithOperation("Subscriptions.ExpireAllMessages").Post(endpoint, nil)
 }
 
 func (s *subscriptions) PeekMessages(topic TopicName, sName string, n int) ([]*Message, error) {
+	return s.PeekMessagesWithOptions(topic, sName, n, nil)
+}
+
+func (s *subscriptions) PeekMessagesWithOptions(topic TopicName, sName string, n int,
+	options *PeekOptions,
+) ([]*Message, error) {
+	if options == nil {
+		options = &PeekOptions{}
+	}
 	var msgs []*Message
+	// reassembled holds the uuids of the messages already returned
+	// reassembled, whose other chunks are skipped.
+	reassembled := make(map[string]bool)
 
 	count := 1
 	for n > 0 {
-		m, err := s.peekNthMessage(topic, sName, count)
+		client := s.pulsar.restClient.WithOperation("Subscriptions.PeekMessagesWithOptions")
+		m, err := s.peekNthMessage(client, topic, sName, count)
 		if err != nil {
 			return nil, err
 		}
+		count++
+		if options.ReassembleChunks && len(m) == 1 && m[0].isChunk() {
+			if reassembled[m[0].UUID] {
+				continue
+			}
+			if m[0].ChunkID == 0 {
+				client := s.pulsar.restClient.WithOperation("Subscriptions.PeekMessagesWithOptions")
+				msg, err := s.reassembleChunks(client, topic, m[0])
+				if err != nil {
+					return nil, err
+				}
+				reassembled[msg.UUID] = true
+				m[0] = msg
+			}
+		}
 		msgs = append(msgs, m...)
 		n -= len(m)
-		count++
 	}
 
 	return msgs, nil
 }
 
-func (s *subscriptions) peekNthMessage(topic TopicName, sName string, pos int) ([]*Message, error) {
+func (s *subscriptions) peekNthMessage(client *rest.Client, topic TopicName, sName string,
+	pos int,
+) ([]*Message, error) {
 	endpoint := s.pulsar.endpoint(s.topicAPI, s.basePath, topic.GetRestPath(), "subscription", url.PathEscape(sName),
 		"position", strconv.Itoa(pos))
 
-	resp, err := s.pulsar.restClient.MakeRequest(http.MethodGet, endpoint)
+	resp, err := client.MakeRequest(http.MethodGet, endpoint)
 	if err != nil {
 		return nil, err
 	}
@@ -186,25 +241,176 @@ func (s *subscriptions) peekNthMessage(topic TopicName, sName string, pos int) (
 }
 
 func (s *subscriptions) GetMessageByID(topic TopicName, ledgerID, entryID int64) (*Message, error) {
+	return s.GetMessageByIDWithOptions(topic, ledgerID, entryID, nil)
+}
+
+func (s *subscriptions) GetMessageByIDWithOptions(topic TopicName, ledgerID, entryID int64,
+	options *PeekOptions,
+) (*Message, error) {
+	messages, err := s.GetMessagesByID(topic, ledgerID, entryID, options)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(messages) == 0 {
+		return nil, nil
+	}
+	return messages[0], nil
+}
+
+func (s *subscriptions) GetMessagesByID(topic TopicName, ledgerID, entryID int64,
+	options *PeekOptions,
+) ([]*Message, error) {
+	client := s.pulsar.restClient.WithOperation("Subscriptions.GetMessagesByID")
+	messages, err := s.getEntry(client, topic, ledgerID, entryID)
+	if err != nil {
+		return nil, err
+	}
+
+	if options != nil && options.ReassembleChunks && len(messages) == 1 && messages[0].isChunk() &&
+		messages[0].ChunkID == 0 {
+		msg, err := s.reassembleChunks(s.pulsar.restClient.WithOperation("Subscriptions.GetMessagesByID"), topic, messages[0])
+		if err != nil {
+			return nil, err
+		}
+		return []*Message{msg}, nil
+	}
+	return messages, nil
+}
+
+func (s *subscriptions) getEntry(client *rest.Client, topic TopicName, ledgerID, entryID int64) ([]*Message, error) {
 	ledgerIDStr := strconv.FormatInt(ledgerID, 10)
 	entryIDStr := strconv.FormatInt(entryID, 10)
 
 	endpoint := s.pulsar.endpoint(s.topicAPI, s.basePath, topic.GetRestPath(), "ledger", ledgerIDStr, "entry", entryIDStr)
-	resp, err := s.pulsar.restClient.MakeRequest(http.MethodGet, endpoint)
+	resp, err := client.MakeRequest(http.MethodGet, endpoint)
 	if err != nil {
 		return nil, err
 	}
 	defer safeRespClose(resp)
 
-	messages, err := handleResp(topic, resp)
+	return handleResp(topic, resp)
+}
+
+// maxChunkScan is the number of entries after the first chunk of a message
+// read to find its other chunks, which can be interleaved with the entries of
+// other producers.
+const maxChunkScan = 1000
+
+// chunkReadBatch is the number of entries read concurrently while looking for
+// the chunks of a message.
+const chunkReadBatch = 16
+
+// entryResult is the outcome of reading an entry.
+type entryResult struct {
+	messages []*Message
+	err      error
+}
+
+// readEntries reads the n entries of a ledger starting at entryID
+// concurrently, and returns them in order.
+func (s *subscriptions) readEntries(client *rest.Client, topic TopicName,
+	ledgerID, entryID int64, n int,
+) []entryResult {
+	results := make([]entryResult, n)
+	var wg sync.WaitGroup
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i].messages, results[i].err = s.getEntry(client, topic, ledgerID, entryID+int64(i))
+		}(i)
+	}
+	wg.Wait()
+	return results
+}
+
+// nextLedger returns the ledger of the topic following ledgerID.
+func (s *subscriptions) nextLedger(topic TopicName, ledgerID int64) (int64, bool, error) {
+	stats, err := s.pulsar.Topics().GetInternalStats(topic)
 	if err != nil {
-		return nil, err
+		return 0, false, err
+	}
+	for _, ledger := range stats.Ledgers {
+		if ledger.LedgerID > ledgerID {
+			return ledger.LedgerID, true, nil
+		}
 	}
+	return 0, false, nil
+}
 
-	if len(messages) == 0 {
-		return nil, nil
+// reassembleChunks returns the message made of the first chunk and of the
+// chunks with the same uuid in the following entries of the topic. Entries
+// are read in batches, and a read past the end of a ledger moves on to the
+// next ledger of the topic.
+func (s *subscriptions) reassembleChunks(client *rest.Client, topic TopicName, first *Message) (*Message, error) {
+	msg := *first
+	msg.Payload = append([]byte(nil), first.Payload...)
+	msg.ChunkMessageIDs = []MessageID{first.MessageID}
+
+	ledgerID, entryID := first.MessageID.LedgerID, first.MessageID.EntryID+1
+	scanned := 0
+	for len(msg.ChunkMessageIDs) < first.NumChunks {
+		if scanned >= maxChunkScan {
+			return nil, fmt.Errorf("chunk %d of message %s not found in the %d following entries",
+				len(msg.ChunkMessageIDs), first.UUID, maxChunkScan)
+		}
+		start := entryID
+		batch := s.readEntries(client, topic, ledgerID, start, chunkReadBatch)
+		entryID += chunkReadBatch
+		for i, entry := range batch {
+			if IsNotFound(entry.err) {
+				// past the last entry of the ledger
+				next, ok, err := s.nextLedger(topic, ledgerID)
+				if err != nil {
+					return nil, fmt.Errorf("read chunk %d of message %s: %w", len(msg.ChunkMessageIDs), first.UUID, err)
+				}
+				if !ok {
+					return nil, fmt.Errorf("read chunk %d of message %s: entry %d:%d is past the end of the topic: %w",
+						len(msg.ChunkMessageIDs), first.UUID, ledgerID, start+int64(i), entry.err)
+				}
+				ledgerID, entryID = next, 0
+				break
+			}
+			if entry.err != nil {
+				return nil, fmt.Errorf("read chunk %d of message %s: %w", len(msg.ChunkMessageIDs), first.UUID, entry.err)
+			}
+			scanned++
+
+			entries := entry.messages
+			if len(entries) != 1 || !entries[0].isChunk() || entries[0].UUID != first.UUID {
+				continue
+			}
+			chunk := entries[0]
+			switch {
+			case chunk.ChunkID < len(msg.ChunkMessageIDs):
+				// a chunk resent by the producer
+				continue
+			case chunk.ChunkID > len(msg.ChunkMessageIDs):
+				return nil, fmt.Errorf("chunk %d of message %s is missing", len(msg.ChunkMessageIDs), first.UUID)
+			}
+			msg.Payload = append(msg.Payload, chunk.Payload...)
+			msg.ChunkMessageIDs = append(msg.ChunkMessageIDs, chunk.MessageID)
+			if len(msg.ChunkMessageIDs) == first.NumChunks {
+				break
+			}
+		}
 	}
-	return messages[0], nil
+
+	if first.TotalChunkMsgSize > 0 && len(msg.Payload) != first.TotalChunkMsgSize {
+		return nil, fmt.Errorf("message %s has %d bytes, expected %d", first.UUID, len(msg.Payload),
+			first.TotalChunkMsgSize)
+	}
+	// a chunked message may be up to NumChunks times as large as one that is not
+	payload, err := compression.Decompress(compression.Type(first.compression), msg.Payload, first.uncompressedSize,
+		first.NumChunks*compression.DefaultMaxSize)
+	if err != nil {
+		return nil, err
+	}
+	msg.Payload = payload
+	msg.ChunkID = 0
+	msg.compression, msg.uncompressedSize = "", 0
+	return &msg, nil
 }
 
 // safeRespClose is used to close a response body
@@ -216,9 +422,24 @@ func safeRespClose(resp *http.Response) {
 }
 
 const (
-	PublishTimeHeader = "X-Pulsar-Publish-Time"
-	BatchHeader       = "X-Pulsar-Num-Batch-Message"
-	PropertyPrefix    = "X-Pulsar-Property-"
+	PublishTimeHeader       = "X-Pulsar-Publish-Time"
+	BatchHeader             = "X-Pulsar-Num-Batch-Message"
+	PropertyPrefix          = "X-Pulsar-Property-"
+	CompressionHeader       = "X-Pulsar-Compression"
+	UncompressedSizeHeader  = "X-Pulsar-Uncompressed-Size"
+	EventTimeHeader         = "X-Pulsar-Event-Time"
+	PartitionKeyHeader      = "X-Pulsar-Partition-Key"
+	PartitionKeyB64Header   = "X-Pulsar-Partition-Key-B64-Encoded"
+	OrderingKeyHeader       = "X-Pulsar-Base64-Ordering-Key"
+	SequenceIDHeader        = "X-Pulsar-Sequence-Id"
+	ProducerNameHeader      = "X-Pulsar-Producer-Name"
+	RedeliveryCountHeader   = "X-Pulsar-Redelivery-Count"
+	ReplicatedFromHeader    = "X-Pulsar-Replicated-From"
+	SchemaVersionHeader     = "X-Pulsar-Base64-Schema-Version-B64encoded"
+	UUIDHeader              = "X-Pulsar-Uuid"
+	ChunkIDHeader           = "X-Pulsar-Chunk-Id"
+	NumChunksHeader         = "X-Pulsar-Num-Chunks-From-Msg"
+	TotalChunkMsgSizeHeader = "X-Pulsar-Total-Chunk-Msg-Size"
 )
 
 func handleResp(topic TopicName, resp *http.Response) ([]*Message, error) {
@@ -228,39 +449,156 @@ func handleResp(topic TopicName, resp *http.Response) ([]*Message, error) {
 		return nil, err
 	}
 
+	entry, err := messageFromHeader(topic, *ID, resp.Header)
+	if err != nil {
+		return nil, err
+	}
+
 	// read data
 	payload, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, err
 	}
+	if entry.isChunk() {
+		// The producer compresses a chunked message before splitting it, so
+		// it is decompressed once reassembled.
+		entry.compression = resp.Header.Get(CompressionHeader)
+		if entry.uncompressedSize, err = intHeader(resp.Header, UncompressedSizeHeader); err != nil {
+			return nil, err
+		}
+		entry.Payload = payload
+		return []*Message{entry}, nil
+	}
+	payload, err = decompressPayload(resp.Header, payload)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := entry.Properties[BatchHeader]; ok {
+		return getIndividualMsgsFromBatch(entry, payload)
+	}
+	entry.Payload = payload
+	return []*Message{entry}, nil
+}
 
+// messageFromHeader returns the message of an entry, without its payload,
+// with the metadata the broker returns in the response headers.
+func messageFromHeader(topic TopicName, id MessageID, header http.Header) (*Message, error) {
 	properties := make(map[string]string)
-	for k := range resp.Header {
+	for k := range header {
 		switch {
 		case k == PublishTimeHeader:
-			h := resp.Header.Get(k)
+			h := header.Get(k)
 			if h != "" {
 				properties["publish-time"] = h
 			}
 		case k == BatchHeader:
-			h := resp.Header.Get(k)
+			h := header.Get(k)
 			if h != "" {
 				properties[BatchHeader] = h
 			}
-			return getIndividualMsgsFromBatch(topic, ID, payload, properties)
 		case strings.Contains(k, PropertyPrefix):
 			key := strings.TrimPrefix(k, PropertyPrefix)
-			properties[key] = resp.Header.Get(k)
+			properties[key] = header.Get(k)
+		}
+	}
+
+	msg := NewMessage(topic.String(), id, nil, properties)
+	msg.Key = header.Get(PartitionKeyHeader)
+	msg.KeyBase64Encoded = header.Get(PartitionKeyB64Header) == "true"
+	msg.ProducerName = header.Get(ProducerNameHeader)
+	msg.ReplicatedFrom = header.Get(ReplicatedFromHeader)
+
+	var err error
+	if msg.PublishTime, err = parseHeaderTime(header, PublishTimeHeader); err != nil {
+		return nil, err
+	}
+	if msg.EventTime, err = parseHeaderTime(header, EventTimeHeader); err != nil {
+		return nil, err
+	}
+	msg.UUID = header.Get(UUIDHeader)
+	if msg.ChunkID, err = intHeader(header, ChunkIDHeader); err != nil {
+		return nil, err
+	}
+	if msg.NumChunks, err = intHeader(header, NumChunksHeader); err != nil {
+		return nil, err
+	}
+	if msg.TotalChunkMsgSize, err = intHeader(header, TotalChunkMsgSizeHeader); err != nil {
+		return nil, err
+	}
+	if h := header.Get(SequenceIDHeader); h != "" {
+		if msg.SequenceID, err = strconv.ParseInt(h, 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid %s header %q: %w", SequenceIDHeader, h, err)
+		}
+	}
+	if h := header.Get(RedeliveryCountHeader); h != "" {
+		count, err := strconv.ParseUint(h, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s header %q: %w", RedeliveryCountHeader, h, err)
+		}
+		msg.RedeliveryCount = uint32(count)
+	}
+	if h := header.Get(OrderingKeyHeader); h != "" {
+		if msg.OrderingKey, err = base64.StdEncoding.DecodeString(h); err != nil {
+			return nil, fmt.Errorf("invalid %s header %q: %w", OrderingKeyHeader, h, err)
 		}
 	}
+	if h := header.Get(SchemaVersionHeader); h != "" {
+		if msg.SchemaVersion, err = base64.StdEncoding.DecodeString(h); err != nil {
+			return nil, fmt.Errorf("invalid %s header %q: %w", SchemaVersionHeader, h, err)
+		}
+	}
+	return msg, nil
+}
 
-	return []*Message{NewMessage(topic.String(), *ID, payload, properties)}, nil
+// parseHeaderTime parses a time header, which the broker formats as an ISO
+// 8601 date, or as milliseconds since the epoch in older versions.
+func parseHeaderTime(header http.Header, name string) (time.Time, error) {
+	h := header.Get(name)
+	if h == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339Nano, h); err == nil {
+		return t, nil
+	}
+	millis, err := strconv.ParseInt(h, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s header %q", name, h)
+	}
+	return time.UnixMilli(millis), nil
 }
 
-func getIndividualMsgsFromBatch(topic TopicName, msgID *MessageID, data []byte,
-	properties map[string]string,
-) ([]*Message, error) {
-	batchSize, err := strconv.Atoi(properties[BatchHeader])
+// decompressPayload decompresses the payload of an entry, which the broker
+// returns as written by the producer, before a batch is split.
+func decompressPayload(header http.Header, payload []byte) ([]byte, error) {
+	codec := header.Get(CompressionHeader)
+	if codec == "" {
+		return payload, nil
+	}
+	size, err := intHeader(header, UncompressedSizeHeader)
+	if err != nil {
+		return nil, err
+	}
+	return compression.Decompress(compression.Type(codec), payload, size, compression.DefaultMaxSize)
+}
+
+// intHeader returns the value of an integer header, zero when it is not set.
+func intHeader(header http.Header, name string) (int, error) {
+	h := header.Get(name)
+	if h == "" {
+		return 0, nil
+	}
+	v, err := strconv.Atoi(h)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s header %q: %w", name, h, err)
+	}
+	return v, nil
+}
+
+// getIndividualMsgsFromBatch splits a batch entry into its messages. Each
+// message starts from a copy of the entry and its properties, overridden by
+// its own metadata.
+func getIndividualMsgsFromBatch(entry *Message, data []byte) ([]*Message, error) {
+	batchSize, err := strconv.Atoi(entry.Properties[BatchHeader])
 	if err != nil {
 		return nil, nil
 	}
@@ -271,7 +609,6 @@ func getIndividualMsgsFromBatch(topic TopicName, msgID *MessageID, data []byte,
 	buf32 := make([]byte, 4)
 	rdBuf := bytes.NewReader(data)
 	for i := 0; i < batchSize; i++ {
-		msgID.BatchIndex = i
 		// singleMetaSize
 		if _, err := io.ReadFull(rdBuf, buf32); err != nil {
 			return nil, err
@@ -289,26 +626,40 @@ func getIndividualMsgsFromBatch(topic TopicName, msgID *MessageID, data []byte,
 			return nil, err
 		}
 
-		if len(singleMeta.Properties) > 0 {
-			for _, v := range singleMeta.Properties {
-				k := *v.Key
-				property := *v.Value
-				properties[k] = property
-			}
-		}
-
 		// payload
 		singlePayload := make([]byte, singleMeta.GetPayloadSize())
 		if _, err := io.ReadFull(rdBuf, singlePayload); err != nil {
 			return nil, err
 		}
 
-		msgs = append(msgs, &Message{
-			Topic:      topic.String(),
-			MessageID:  *msgID,
-			Payload:    singlePayload,
-			Properties: properties,
-		})
+		msg := *entry
+		msg.MessageID.BatchIndex = i
+		msg.Payload = singlePayload
+		msg.Properties = make(map[string]string, len(entry.Properties)+len(singleMeta.Properties))
+		for k, v := range entry.Properties {
+			msg.Properties[k] = v
+		}
+		for _, v := range singleMeta.Properties {
+			msg.Properties[v.GetKey()] = v.GetValue()
+		}
+		if singleMeta.PartitionKey != nil {
+			msg.Key = singleMeta.GetPartitionKey()
+			msg.KeyBase64Encoded = singleMeta.GetPartitionKeyB64Encoded()
+		}
+		if singleMeta.OrderingKey != nil {
+			msg.OrderingKey = singleMeta.OrderingKey
+		}
+		if eventTime := singleMeta.GetEventTime(); eventTime > 0 {
+			msg.EventTime = time.UnixMilli(int64(eventTime))
+		}
+		if singleMeta.SequenceId != nil {
+			msg.SequenceID = int64(singleMeta.GetSequenceId())
+		} else {
+			// the entry carries the sequence id of the first message of the
+			// batch, and the producer numbers the others consecutively
+			msg.SequenceID = entry.SequenceID + int64(i)
+		}
+		msgs = append(msgs, &msg)
 	}
 
 	return msgs, nil
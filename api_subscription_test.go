@@ -0,0 +1,341 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsaradmin
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+	"github.com/pierrec/lz4/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type batchEntry struct {
+	payload  string
+	metadata *SingleMessageMetadata
+}
+
+// batchPayload returns the uncompressed payload of a batch entry.
+func batchPayload(t *testing.T, entries ...batchEntry) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	for _, e := range entries {
+		metadata := e.metadata
+		if metadata == nil {
+			metadata = &SingleMessageMetadata{}
+		}
+		metadata.PayloadSize = proto.Int32(int32(len(e.payload)))
+		meta, err := proto.Marshal(metadata)
+		require.NoError(t, err)
+		require.NoError(t, binary.Write(&buf, binary.BigEndian, uint32(len(meta))))
+		buf.Write(meta)
+		buf.WriteString(e.payload)
+	}
+	return buf.Bytes()
+}
+
+func TestPeekMessagesDecompressesBatches(t *testing.T) {
+	batch := batchPayload(t, batchEntry{payload: "first"}, batchEntry{payload: "second"})
+
+	lz4Batch := make([]byte, lz4.CompressBlockBound(len(batch)))
+	n, err := lz4.CompressBlock(batch, lz4Batch, nil)
+	require.NoError(t, err)
+	lz4Batch = lz4Batch[:n]
+
+	var zlibBatch bytes.Buffer
+	w := zlib.NewWriter(&zlibBatch)
+	_, err = w.Write(batch)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	entries := map[string]struct {
+		compression string
+		payload     []byte
+	}{
+		"1": {"LZ4", lz4Batch},
+		"2": {"ZLIB", zlibBatch.Bytes()},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		entry := entries[r.URL.Path[len(r.URL.Path)-1:]]
+		w.Header().Set("X-Pulsar-Message-ID", "1:"+r.URL.Path[len(r.URL.Path)-1:])
+		w.Header().Set("X-Pulsar-num-batch-message", "2")
+		w.Header().Set("X-Pulsar-compression", entry.compression)
+		w.Header().Set("X-Pulsar-uncompressed-size", strconv.Itoa(len(batch)))
+		_, _ = w.Write(entry.payload)
+	}))
+	defer server.Close()
+
+	admin, err := NewClient(ClientConfig{WebServiceURL: server.URL})
+	require.NoError(t, err)
+	topic, err := GetTopicName("persistent://public/default/orders")
+	require.NoError(t, err)
+
+	msgs, err := admin.Subscriptions().PeekMessages(*topic, "sub", 4)
+	require.NoError(t, err)
+	require.Len(t, msgs, 4)
+	for i, want := range []string{"first", "second", "first", "second"} {
+		assert.Equal(t, want, string(msgs[i].Payload))
+		assert.Equal(t, int64(i/2+1), msgs[i].MessageID.EntryID)
+	}
+}
+
+func TestPeekMessagesUnsupportedCompression(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Pulsar-Message-ID", "1:1")
+		w.Header().Set("X-Pulsar-compression", "BROTLI")
+		_, _ = w.Write([]byte("payload"))
+	}))
+	defer server.Close()
+
+	admin, err := NewClient(ClientConfig{WebServiceURL: server.URL})
+	require.NoError(t, err)
+	topic, err := GetTopicName("persistent://public/default/orders")
+	require.NoError(t, err)
+
+	_, err = admin.Subscriptions().PeekMessages(*topic, "sub", 1)
+	assert.ErrorContains(t, err, "unsupported compression type")
+}
+
+func TestPeekMessagesMetadata(t *testing.T) {
+	batch := batchPayload(t,
+		batchEntry{payload: "first", metadata: &SingleMessageMetadata{
+			Properties:   []*KeyValue{{Key: proto.String("k"), Value: proto.String("first")}},
+			PartitionKey: proto.String("key-1"),
+			OrderingKey:  []byte("order-1"),
+			EventTime:    proto.Uint64(1700000000000),
+			SequenceId:   proto.Uint64(9),
+		}},
+		batchEntry{payload: "second"},
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Pulsar-Message-ID", "3:4")
+		w.Header().Set("X-Pulsar-num-batch-message", "2")
+		w.Header().Set("X-Pulsar-publish-time", "2023-11-14T22:13:20.5Z")
+		w.Header().Set("X-Pulsar-partition-key", "batch-key")
+		w.Header().Set("X-Pulsar-sequence-id", "6")
+		w.Header().Set("X-Pulsar-producer-name", "standalone-0-1")
+		w.Header().Set("X-Pulsar-replicated-from", "west")
+		w.Header().Set("X-Pulsar-Base64-schema-version-b64encoded", "AAAAAAAAAAE=")
+		w.Header().Set("X-Pulsar-PROPERTY-origin", "batch")
+		_, _ = w.Write(batch)
+	}))
+	defer server.Close()
+
+	admin, err := NewClient(ClientConfig{WebServiceURL: server.URL})
+	require.NoError(t, err)
+	topic, err := GetTopicName("persistent://public/default/orders")
+	require.NoError(t, err)
+
+	msgs, err := admin.Subscriptions().PeekMessages(*topic, "sub", 2)
+	require.NoError(t, err)
+	require.Len(t, msgs, 2)
+	first, second := msgs[0], msgs[1]
+
+	publishTime := time.Date(2023, 11, 14, 22, 13, 20, 5e8, time.UTC)
+	for i, msg := range msgs {
+		assert.Equal(t, MessageID{LedgerID: 3, EntryID: 4, BatchIndex: i, PartitionedIndex: -1}, msg.MessageID)
+		assert.True(t, publishTime.Equal(msg.PublishTime))
+		assert.Equal(t, "standalone-0-1", msg.ProducerName)
+		assert.Equal(t, "west", msg.ReplicatedFrom)
+		assert.Equal(t, []byte{0, 0, 0, 0, 0, 0, 0, 1}, msg.SchemaVersion)
+		assert.Equal(t, "batch", msg.Properties["Origin"])
+	}
+
+	assert.Equal(t, "key-1", first.Key)
+	assert.Equal(t, []byte("order-1"), first.OrderingKey)
+	assert.Equal(t, int64(1700000000000), first.EventTime.UnixMilli())
+	assert.Equal(t, int64(9), first.SequenceID)
+	assert.Equal(t, "first", first.Properties["k"])
+
+	assert.Equal(t, "batch-key", second.Key)
+	assert.Nil(t, second.OrderingKey)
+	assert.True(t, second.EventTime.IsZero())
+	// without a sequence id of its own, it follows the one of the entry
+	assert.Equal(t, int64(7), second.SequenceID)
+	assert.NotContains(t, second.Properties, "k")
+}
+
+type testEntry struct {
+	header  map[string]string
+	payload []byte
+}
+
+// entryServer serves the entries of ledger 5, at their index and at the
+// position following it.
+func entryServer(t *testing.T, entries []testEntry) Subscriptions {
+	t.Helper()
+	return ledgerServer(t, map[int64][]testEntry{5: entries})
+}
+
+// ledgerServer serves the entries of the given ledgers by ledger and entry,
+// the entries of ledger 5 at the position following their index, and the
+// ledgers in the internal stats of the topic.
+func ledgerServer(t *testing.T, ledgers map[int64][]testEntry) Subscriptions {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		parts := strings.Split(r.URL.Path, "/")
+		if parts[len(parts)-1] == "internalStats" {
+			var stats PersistentTopicInternalStats
+			for ledgerID := range ledgers {
+				stats.Ledgers = append(stats.Ledgers, LedgerInfo{LedgerID: ledgerID})
+			}
+			sort.Slice(stats.Ledgers, func(i, j int) bool { return stats.Ledgers[i].LedgerID < stats.Ledgers[j].LedgerID })
+			_ = json.NewEncoder(w).Encode(stats)
+			return
+		}
+		index, err := strconv.Atoi(parts[len(parts)-1])
+		require.NoError(t, err)
+		ledgerID := int64(5)
+		if parts[len(parts)-2] == "position" {
+			index--
+		} else {
+			ledgerID, err = strconv.ParseInt(parts[len(parts)-3], 10, 64)
+			require.NoError(t, err)
+		}
+		entries := ledgers[ledgerID]
+		if index < 0 || index >= len(entries) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"reason":"Message not found"}`))
+			return
+		}
+		w.Header().Set("X-Pulsar-Message-ID", strconv.FormatInt(ledgerID, 10)+":"+strconv.Itoa(index))
+		for k, v := range entries[index].header {
+			w.Header().Set(k, v)
+		}
+		_, _ = w.Write(entries[index].payload)
+	}))
+	t.Cleanup(server.Close)
+
+	admin, err := NewClient(ClientConfig{WebServiceURL: server.URL})
+	require.NoError(t, err)
+	return admin.Subscriptions()
+}
+
+func TestPeekMessagesReassemblesChunks(t *testing.T) {
+	message := bytes.Repeat([]byte("chunked payload "), 16)
+	var compressed bytes.Buffer
+	w := zlib.NewWriter(&compressed)
+	_, err := w.Write(message)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	data := compressed.Bytes()
+	third := len(data) / 3
+
+	chunk := func(id int, payload []byte) testEntry {
+		return testEntry{header: map[string]string{
+			"X-Pulsar-uuid":                 "producer-0-1",
+			"X-Pulsar-chunk-id":             strconv.Itoa(id),
+			"X-Pulsar-num-chunks-from-msg":  "3",
+			"X-Pulsar-total-chunk-msg-size": strconv.Itoa(len(data)),
+			"X-Pulsar-compression":          "ZLIB",
+			"X-Pulsar-uncompressed-size":    strconv.Itoa(len(message)),
+		}, payload: payload}
+	}
+	subs := entryServer(t, []testEntry{
+		chunk(0, data[:third]),
+		{payload: []byte("other")},
+		chunk(1, data[third:2*third]),
+		chunk(1, data[third:2*third]),
+		chunk(2, data[2*third:]),
+		{payload: []byte("last")},
+	})
+	topic, err := GetTopicName("persistent://public/default/orders")
+	require.NoError(t, err)
+
+	msgs, err := subs.PeekMessagesWithOptions(*topic, "sub", 3, &PeekOptions{ReassembleChunks: true})
+	require.NoError(t, err)
+	require.Len(t, msgs, 3)
+	assert.Equal(t, message, msgs[0].Payload)
+	assert.Equal(t, "producer-0-1", msgs[0].UUID)
+	assert.Equal(t, 3, msgs[0].NumChunks)
+	var entryIDs []int64
+	for _, id := range msgs[0].ChunkMessageIDs {
+		entryIDs = append(entryIDs, id.EntryID)
+	}
+	assert.Equal(t, []int64{0, 2, 4}, entryIDs)
+	assert.Equal(t, "other", string(msgs[1].Payload))
+	assert.Equal(t, "last", string(msgs[2].Payload))
+
+	msgs, err = subs.PeekMessages(*topic, "sub", 2)
+	require.NoError(t, err)
+	assert.Equal(t, data[:third], msgs[0].Payload)
+	assert.Equal(t, 0, msgs[0].ChunkID)
+	assert.Empty(t, msgs[0].ChunkMessageIDs)
+
+	msg, err := subs.GetMessageByIDWithOptions(*topic, 5, 0, &PeekOptions{ReassembleChunks: true})
+	require.NoError(t, err)
+	assert.Equal(t, message, msg.Payload)
+
+	msg, err = subs.GetMessageByIDWithOptions(*topic, 5, 2, &PeekOptions{ReassembleChunks: true})
+	require.NoError(t, err)
+	assert.Equal(t, 1, msg.ChunkID)
+	assert.Equal(t, data[third:2*third], msg.Payload)
+}
+
+func TestPeekMessagesIncompleteChunkedMessage(t *testing.T) {
+	subs := entryServer(t, []testEntry{{header: map[string]string{
+		"X-Pulsar-uuid":                "producer-0-1",
+		"X-Pulsar-chunk-id":            "0",
+		"X-Pulsar-num-chunks-from-msg": "2",
+	}, payload: []byte("first")}})
+	topic, err := GetTopicName("persistent://public/default/orders")
+	require.NoError(t, err)
+
+	_, err = subs.PeekMessagesWithOptions(*topic, "sub", 1, &PeekOptions{ReassembleChunks: true})
+	assert.ErrorContains(t, err, "read chunk 1 of message producer-0-1: entry 5:1 is past the end of the topic")
+	assert.True(t, IsNotFound(err))
+}
+
+func TestPeekMessagesReassemblesChunksAcrossLedgers(t *testing.T) {
+	chunk := func(id int, payload string) testEntry {
+		return testEntry{header: map[string]string{
+			"X-Pulsar-uuid":                "producer-0-1",
+			"X-Pulsar-chunk-id":            strconv.Itoa(id),
+			"X-Pulsar-num-chunks-from-msg": "3",
+		}, payload: []byte(payload)}
+	}
+	other := make([]testEntry, 2*chunkReadBatch)
+	for i := range other {
+		other[i] = testEntry{payload: []byte("other")}
+	}
+	subs := ledgerServer(t, map[int64][]testEntry{
+		5: {chunk(0, "first ")},
+		6: append(other, chunk(1, "second ")),
+		8: {chunk(2, "third")},
+	})
+	topic, err := GetTopicName("persistent://public/default/orders")
+	require.NoError(t, err)
+
+	msg, err := subs.GetMessageByIDWithOptions(*topic, 5, 0, &PeekOptions{ReassembleChunks: true})
+	require.NoError(t, err)
+	assert.Equal(t, "first second third", string(msg.Payload))
+	var positions [][2]int64
+	for _, id := range msg.ChunkMessageIDs {
+		positions = append(positions, [2]int64{id.LedgerID, id.EntryID})
+	}
+	assert.Equal(t, [][2]int64{{5, 0}, {6, 2 * chunkReadBatch}, {8, 0}}, positions)
+}
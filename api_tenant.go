@@ -50,29 +50,29 @@ func (c *pulsarClient) Tenants() Tenants {
 
 func (c *tenants) Create(data TenantData) error {
 	endpoint := c.pulsar.endpoint(c.apiVersion, c.basePath, data.Name)
-	return c.pulsar.restClient.Put(endpoint, &data)
+	return c.pulsar.restClient.WithOperation("Tenants.Create").Put(endpoint, &data)
 }
 
 func (c *tenants) Delete(name string) error {
 	endpoint := c.pulsar.endpoint(c.apiVersion, c.basePath, name)
-	return c.pulsar.restClient.Delete(endpoint)
+	return c.pulsar.restClient.WithOperation("Tenants.Delete").Delete(endpoint)
 }
 
 func (c *tenants) Update(data TenantData) error {
 	endpoint := c.pulsar.endpoint(c.apiVersion, c.basePath, data.Name)
-	return c.pulsar.restClient.Post(endpoint, &data)
+	return c.pulsar.restClient.WithOperation("Tenants.Update").Post(endpoint, &data)
 }
 
 func (c *tenants) List() ([]string, error) {
 	var tenantList []string
 	endpoint := c.pulsar.endpoint(c.apiVersion, c.basePath, "")
-	err := c.pulsar.restClient.Get(endpoint, &tenantList)
+	err := c.pulsar.restClient.WithOperation("Tenants.List").Get(endpoint, &tenantList)
 	return tenantList, err
 }
 
 func (c *tenants) Get(name string) (TenantData, error) {
 	var data TenantData
 	endpoint := c.pulsar.endpoint(c.apiVersion, c.basePath, name)
-	err := c.pulsar.restClient.Get(endpoint, &data)
+	err := c.pulsar.restClient.WithOperation("Tenants.Get").Get(endpoint, &data)
 	return data, err
 }
@@ -262,7 +262,7 @@ func (t *topics) Create(topic TopicName, partitions int) error {
 		data = nil
 	}
 
-	return t.pulsar.restClient.Put(endpoint, data)
+	return t.pulsar.restClient.WithOperation("Topics.Create").Put(endpoint, data)
 }
 
 func (t *topics) Delete(topic TopicName, force bool, nonPartitioned bool) error {
@@ -273,18 +273,18 @@ func (t *topics) Delete(topic TopicName, force bool, nonPartitioned bool) error
 	params := map[string]string{
 		"force": strconv.FormatBool(force),
 	}
-	return t.pulsar.restClient.DeleteWithQueryParams(endpoint, params)
+	return t.pulsar.restClient.WithOperation("Topics.Delete").DeleteWithQueryParams(endpoint, params)
 }
 
 func (t *topics) Update(topic TopicName, partitions int) error {
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "partitions")
-	return t.pulsar.restClient.Post(endpoint, partitions)
+	return t.pulsar.restClient.WithOperation("Topics.Update").Post(endpoint, partitions)
 }
 
 func (t *topics) GetMetadata(topic TopicName) (PartitionedTopicMetadata, error) {
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "partitions")
 	var partitionedMeta PartitionedTopicMetadata
-	err := t.pulsar.restClient.Get(endpoint, &partitionedMeta)
+	err := t.pulsar.restClient.WithOperation("Topics.GetMetadata").Get(endpoint, &partitionedMeta)
 	return partitionedMeta, err
 }
 
@@ -328,21 +328,21 @@ func (t *topics) List(namespace NameSpaceName) ([]string, []string, error) {
 
 func (t *topics) getTopics(endpoint string, out chan<- []string, err chan<- error) {
 	var topics []string
-	err <- t.pulsar.restClient.Get(endpoint, &topics)
+	err <- t.pulsar.restClient.WithOperation("Topics.List").Get(endpoint, &topics)
 	out <- topics
 }
 
 func (t *topics) GetInternalInfo(topic TopicName) (ManagedLedgerInfo, error) {
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "internal-info")
 	var info ManagedLedgerInfo
-	err := t.pulsar.restClient.Get(endpoint, &info)
+	err := t.pulsar.restClient.WithOperation("Topics.GetInternalInfo").Get(endpoint, &info)
 	return info, err
 }
 
 func (t *topics) GetPermissions(topic TopicName) (map[string][]AuthAction, error) {
 	var permissions map[string][]AuthAction
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "permissions")
-	err := t.pulsar.restClient.Get(endpoint, &permissions)
+	err := t.pulsar.restClient.WithOperation("Topics.GetPermissions").Get(endpoint, &permissions)
 	return permissions, err
 }
 
@@ -352,31 +352,31 @@ func (t *topics) GrantPermission(topic TopicName, role string, action []AuthActi
 	for _, v := range action {
 		s = append(s, v.String())
 	}
-	return t.pulsar.restClient.Post(endpoint, s)
+	return t.pulsar.restClient.WithOperation("Topics.GrantPermission").Post(endpoint, s)
 }
 
 func (t *topics) RevokePermission(topic TopicName, role string) error {
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "permissions", role)
-	return t.pulsar.restClient.Delete(endpoint)
+	return t.pulsar.restClient.WithOperation("Topics.RevokePermission").Delete(endpoint)
 }
 
 func (t *topics) Lookup(topic TopicName) (LookupData, error) {
 	var lookup LookupData
 	endpoint := fmt.Sprintf("%s/%s", t.lookupPath, topic.GetRestPath())
-	err := t.pulsar.restClient.Get(endpoint, &lookup)
+	err := t.pulsar.restClient.WithOperation("Topics.Lookup").Get(endpoint, &lookup)
 	return lookup, err
 }
 
 func (t *topics) GetBundleRange(topic TopicName) (string, error) {
 	endpoint := fmt.Sprintf("%s/%s/%s", t.lookupPath, topic.GetRestPath(), "bundle")
-	data, err := t.pulsar.restClient.GetWithQueryParams(endpoint, nil, nil, false)
+	data, err := t.pulsar.restClient.WithOperation("Topics.GetBundleRange").GetWithQueryParams(endpoint, nil, nil, false)
 	return string(data), err
 }
 
 func (t *topics) GetLastMessageID(topic TopicName) (MessageID, error) {
 	var messageID MessageID
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "lastMessageId")
-	err := t.pulsar.restClient.Get(endpoint, &messageID)
+	err := t.pulsar.restClient.WithOperation("Topics.GetLastMessageID").Get(endpoint, &messageID)
 	return messageID, err
 }
 
@@ -384,21 +384,21 @@ func (t *topics) GetMessageID(topic TopicName, timestamp int64) (MessageID, erro
 	var messageID MessageID
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "messageid",
 		strconv.FormatInt(timestamp, 10))
-	err := t.pulsar.restClient.Get(endpoint, &messageID)
+	err := t.pulsar.restClient.WithOperation("Topics.GetMessageID").Get(endpoint, &messageID)
 	return messageID, err
 }
 
 func (t *topics) GetStats(topic TopicName) (TopicStats, error) {
 	var stats TopicStats
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "stats")
-	err := t.pulsar.restClient.Get(endpoint, &stats)
+	err := t.pulsar.restClient.WithOperation("Topics.GetStats").Get(endpoint, &stats)
 	return stats, err
 }
 
 func (t *topics) GetInternalStats(topic TopicName) (PersistentTopicInternalStats, error) {
 	var stats PersistentTopicInternalStats
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "internalStats")
-	err := t.pulsar.restClient.Get(endpoint, &stats)
+	err := t.pulsar.restClient.WithOperation("Topics.GetInternalStats").Get(endpoint, &stats)
 	return stats, err
 }
 
@@ -408,50 +408,51 @@ func (t *topics) GetPartitionedStats(topic TopicName, perPartition bool) (Partit
 	params := map[string]string{
 		"perPartition": strconv.FormatBool(perPartition),
 	}
-	_, err := t.pulsar.restClient.GetWithQueryParams(endpoint, &stats, params, true)
+	client := t.pulsar.restClient.WithOperation("Topics.GetPartitionedStats")
+	_, err := client.GetWithQueryParams(endpoint, &stats, params, true)
 	return stats, err
 }
 
 func (t *topics) Terminate(topic TopicName) (MessageID, error) {
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "terminate")
 	var messageID MessageID
-	err := t.pulsar.restClient.PostWithObj(endpoint, nil, &messageID)
+	err := t.pulsar.restClient.WithOperation("Topics.Terminate").PostWithObj(endpoint, nil, &messageID)
 	return messageID, err
 }
 
 func (t *topics) Offload(topic TopicName, messageID MessageID) error {
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "offload")
-	return t.pulsar.restClient.Put(endpoint, messageID)
+	return t.pulsar.restClient.WithOperation("Topics.Offload").Put(endpoint, messageID)
 }
 
 func (t *topics) OffloadStatus(topic TopicName) (OffloadProcessStatus, error) {
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "offload")
 	var status OffloadProcessStatus
-	err := t.pulsar.restClient.Get(endpoint, &status)
+	err := t.pulsar.restClient.WithOperation("Topics.OffloadStatus").Get(endpoint, &status)
 	return status, err
 }
 
 func (t *topics) Unload(topic TopicName) error {
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "unload")
-	return t.pulsar.restClient.Put(endpoint, nil)
+	return t.pulsar.restClient.WithOperation("Topics.Unload").Put(endpoint, nil)
 }
 
 func (t *topics) Compact(topic TopicName) error {
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "compaction")
-	return t.pulsar.restClient.Put(endpoint, nil)
+	return t.pulsar.restClient.WithOperation("Topics.Compact").Put(endpoint, nil)
 }
 
 func (t *topics) CompactStatus(topic TopicName) (LongRunningProcessStatus, error) {
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "compaction")
 	var status LongRunningProcessStatus
-	err := t.pulsar.restClient.Get(endpoint, &status)
+	err := t.pulsar.restClient.WithOperation("Topics.CompactStatus").Get(endpoint, &status)
 	return status, err
 }
 
 func (t *topics) GetMessageTTL(topic TopicName) (int, error) {
 	var ttl int
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "messageTTL")
-	err := t.pulsar.restClient.Get(endpoint, &ttl)
+	err := t.pulsar.restClient.WithOperation("Topics.GetMessageTTL").Get(endpoint, &ttl)
 	return ttl, err
 }
 
@@ -459,7 +460,7 @@ func (t *topics) SetMessageTTL(topic TopicName, messageTTL int) error {
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "messageTTL")
 	params := make(map[string]string)
 	params["messageTTL"] = strconv.Itoa(messageTTL)
-	err := t.pulsar.restClient.PostWithQueryParams(endpoint, nil, params)
+	err := t.pulsar.restClient.WithOperation("Topics.SetMessageTTL").PostWithQueryParams(endpoint, nil, params)
 	return err
 }
 
@@ -467,171 +468,172 @@ func (t *topics) RemoveMessageTTL(topic TopicName) error {
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "messageTTL")
 	params := make(map[string]string)
 	params["messageTTL"] = strconv.Itoa(0)
-	err := t.pulsar.restClient.DeleteWithQueryParams(endpoint, params)
+	err := t.pulsar.restClient.WithOperation("Topics.RemoveMessageTTL").DeleteWithQueryParams(endpoint, params)
 	return err
 }
 
 func (t *topics) GetMaxProducers(topic TopicName) (int, error) {
 	var maxProducers int
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "maxProducers")
-	err := t.pulsar.restClient.Get(endpoint, &maxProducers)
+	err := t.pulsar.restClient.WithOperation("Topics.GetMaxProducers").Get(endpoint, &maxProducers)
 	return maxProducers, err
 }
 
 func (t *topics) SetMaxProducers(topic TopicName, maxProducers int) error {
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "maxProducers")
-	err := t.pulsar.restClient.Post(endpoint, &maxProducers)
+	err := t.pulsar.restClient.WithOperation("Topics.SetMaxProducers").Post(endpoint, &maxProducers)
 	return err
 }
 
 func (t *topics) RemoveMaxProducers(topic TopicName) error {
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "maxProducers")
-	err := t.pulsar.restClient.Delete(endpoint)
+	err := t.pulsar.restClient.WithOperation("Topics.RemoveMaxProducers").Delete(endpoint)
 	return err
 }
 
 func (t *topics) GetMaxConsumers(topic TopicName) (int, error) {
 	var maxConsumers int
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "maxConsumers")
-	err := t.pulsar.restClient.Get(endpoint, &maxConsumers)
+	err := t.pulsar.restClient.WithOperation("Topics.GetMaxConsumers").Get(endpoint, &maxConsumers)
 	return maxConsumers, err
 }
 
 func (t *topics) SetMaxConsumers(topic TopicName, maxConsumers int) error {
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "maxConsumers")
-	err := t.pulsar.restClient.Post(endpoint, &maxConsumers)
+	err := t.pulsar.restClient.WithOperation("Topics.SetMaxConsumers").Post(endpoint, &maxConsumers)
 	return err
 }
 
 func (t *topics) RemoveMaxConsumers(topic TopicName) error {
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "maxConsumers")
-	err := t.pulsar.restClient.Delete(endpoint)
+	err := t.pulsar.restClient.WithOperation("Topics.RemoveMaxConsumers").Delete(endpoint)
 	return err
 }
 
 func (t *topics) GetMaxUnackMessagesPerConsumer(topic TopicName) (int, error) {
 	var maxNum int
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "maxUnackedMessagesOnConsumer")
-	err := t.pulsar.restClient.Get(endpoint, &maxNum)
+	err := t.pulsar.restClient.WithOperation("Topics.GetMaxUnackMessagesPerConsumer").Get(endpoint, &maxNum)
 	return maxNum, err
 }
 
 func (t *topics) SetMaxUnackMessagesPerConsumer(topic TopicName, maxUnackedNum int) error {
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "maxUnackedMessagesOnConsumer")
-	return t.pulsar.restClient.Post(endpoint, &maxUnackedNum)
+	return t.pulsar.restClient.WithOperation("Topics.SetMaxUnackMessagesPerConsumer").Post(endpoint, &maxUnackedNum)
 }
 
 func (t *topics) RemoveMaxUnackMessagesPerConsumer(topic TopicName) error {
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "maxUnackedMessagesOnConsumer")
-	return t.pulsar.restClient.Delete(endpoint)
+	return t.pulsar.restClient.WithOperation("Topics.RemoveMaxUnackMessagesPerConsumer").Delete(endpoint)
 }
 
 func (t *topics) GetMaxUnackMessagesPerSubscription(topic TopicName) (int, error) {
 	var maxNum int
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "maxUnackedMessagesOnSubscription")
-	err := t.pulsar.restClient.Get(endpoint, &maxNum)
+	err := t.pulsar.restClient.WithOperation("Topics.GetMaxUnackMessagesPerSubscription").Get(endpoint, &maxNum)
 	return maxNum, err
 }
 
 func (t *topics) SetMaxUnackMessagesPerSubscription(topic TopicName, maxUnackedNum int) error {
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "maxUnackedMessagesOnSubscription")
-	return t.pulsar.restClient.Post(endpoint, &maxUnackedNum)
+	return t.pulsar.restClient.WithOperation("Topics.SetMaxUnackMessagesPerSubscription").Post(endpoint, &maxUnackedNum)
 }
 
 func (t *topics) RemoveMaxUnackMessagesPerSubscription(topic TopicName) error {
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "maxUnackedMessagesOnSubscription")
-	return t.pulsar.restClient.Delete(endpoint)
+	return t.pulsar.restClient.WithOperation("Topics.RemoveMaxUnackMessagesPerSubscription").Delete(endpoint)
 }
 
 func (t *topics) GetPersistence(topic TopicName) (*PersistenceData, error) {
 	var persistenceData PersistenceData
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "persistence")
-	err := t.pulsar.restClient.Get(endpoint, &persistenceData)
+	err := t.pulsar.restClient.WithOperation("Topics.GetPersistence").Get(endpoint, &persistenceData)
 	return &persistenceData, err
 }
 
 func (t *topics) SetPersistence(topic TopicName, persistenceData PersistenceData) error {
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "persistence")
-	return t.pulsar.restClient.Post(endpoint, &persistenceData)
+	return t.pulsar.restClient.WithOperation("Topics.SetPersistence").Post(endpoint, &persistenceData)
 }
 
 func (t *topics) RemovePersistence(topic TopicName) error {
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "persistence")
-	return t.pulsar.restClient.Delete(endpoint)
+	return t.pulsar.restClient.WithOperation("Topics.RemovePersistence").Delete(endpoint)
 }
 
 func (t *topics) GetDelayedDelivery(topic TopicName) (*DelayedDeliveryData, error) {
 	var delayedDeliveryData DelayedDeliveryData
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "delayedDelivery")
-	err := t.pulsar.restClient.Get(endpoint, &delayedDeliveryData)
+	err := t.pulsar.restClient.WithOperation("Topics.GetDelayedDelivery").Get(endpoint, &delayedDeliveryData)
 	return &delayedDeliveryData, err
 }
 
 func (t *topics) SetDelayedDelivery(topic TopicName, delayedDeliveryData DelayedDeliveryData) error {
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "delayedDelivery")
-	return t.pulsar.restClient.Post(endpoint, &delayedDeliveryData)
+	return t.pulsar.restClient.WithOperation("Topics.SetDelayedDelivery").Post(endpoint, &delayedDeliveryData)
 }
 
 func (t *topics) RemoveDelayedDelivery(topic TopicName) error {
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "delayedDelivery")
-	return t.pulsar.restClient.Delete(endpoint)
+	return t.pulsar.restClient.WithOperation("Topics.RemoveDelayedDelivery").Delete(endpoint)
 }
 
 func (t *topics) GetDispatchRate(topic TopicName) (*DispatchRateData, error) {
 	var dispatchRateData DispatchRateData
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "dispatchRate")
-	err := t.pulsar.restClient.Get(endpoint, &dispatchRateData)
+	err := t.pulsar.restClient.WithOperation("Topics.GetDispatchRate").Get(endpoint, &dispatchRateData)
 	return &dispatchRateData, err
 }
 
 func (t *topics) SetDispatchRate(topic TopicName, dispatchRateData DispatchRateData) error {
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "dispatchRate")
-	return t.pulsar.restClient.Post(endpoint, &dispatchRateData)
+	return t.pulsar.restClient.WithOperation("Topics.SetDispatchRate").Post(endpoint, &dispatchRateData)
 }
 
 func (t *topics) RemoveDispatchRate(topic TopicName) error {
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "dispatchRate")
-	return t.pulsar.restClient.Delete(endpoint)
+	return t.pulsar.restClient.WithOperation("Topics.RemoveDispatchRate").Delete(endpoint)
 }
 
 func (t *topics) GetPublishRate(topic TopicName) (*PublishRateData, error) {
 	var publishRateData PublishRateData
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "publishRate")
-	err := t.pulsar.restClient.Get(endpoint, &publishRateData)
+	err := t.pulsar.restClient.WithOperation("Topics.GetPublishRate").Get(endpoint, &publishRateData)
 	return &publishRateData, err
 }
 
 func (t *topics) SetPublishRate(topic TopicName, publishRateData PublishRateData) error {
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "publishRate")
-	return t.pulsar.restClient.Post(endpoint, &publishRateData)
+	return t.pulsar.restClient.WithOperation("Topics.SetPublishRate").Post(endpoint, &publishRateData)
 }
 
 func (t *topics) RemovePublishRate(topic TopicName) error {
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "publishRate")
-	return t.pulsar.restClient.Delete(endpoint)
+	return t.pulsar.restClient.WithOperation("Topics.RemovePublishRate").Delete(endpoint)
 }
 
 func (t *topics) GetDeduplicationStatus(topic TopicName) (bool, error) {
 	var enabled bool
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "deduplicationEnabled")
-	err := t.pulsar.restClient.Get(endpoint, &enabled)
+	err := t.pulsar.restClient.WithOperation("Topics.GetDeduplicationStatus").Get(endpoint, &enabled)
 	return enabled, err
 }
 
 func (t *topics) SetDeduplicationStatus(topic TopicName, enabled bool) error {
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "deduplicationEnabled")
-	return t.pulsar.restClient.Post(endpoint, enabled)
+	return t.pulsar.restClient.WithOperation("Topics.SetDeduplicationStatus").Post(endpoint, enabled)
 }
 
 func (t *topics) RemoveDeduplicationStatus(topic TopicName) error {
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "deduplicationEnabled")
-	return t.pulsar.restClient.Delete(endpoint)
+	return t.pulsar.restClient.WithOperation("Topics.RemoveDeduplicationStatus").Delete(endpoint)
 }
 
 func (t *topics) GetRetention(topic TopicName, applied bool) (*RetentionPolicies, error) {
 	var policy RetentionPolicies
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "retention")
-	_, err := t.pulsar.restClient.GetWithQueryParams(endpoint, &policy, map[string]string{
+	client := t.pulsar.restClient.WithOperation("Topics.GetRetention")
+	_, err := client.GetWithQueryParams(endpoint, &policy, map[string]string{
 		"applied": strconv.FormatBool(applied),
 	}, true)
 	return &policy, err
@@ -639,18 +641,19 @@ func (t *topics) GetRetention(topic TopicName, applied bool) (*RetentionPolicies
 
 func (t *topics) RemoveRetention(topic TopicName) error {
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "retention")
-	return t.pulsar.restClient.Delete(endpoint)
+	return t.pulsar.restClient.WithOperation("Topics.RemoveRetention").Delete(endpoint)
 }
 
 func (t *topics) SetRetention(topic TopicName, data RetentionPolicies) error {
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "retention")
-	return t.pulsar.restClient.Post(endpoint, data)
+	return t.pulsar.restClient.WithOperation("Topics.SetRetention").Post(endpoint, data)
 }
 
 func (t *topics) GetCompactionThreshold(topic TopicName, applied bool) (int64, error) {
 	var threshold int64
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "compactionThreshold")
-	_, err := t.pulsar.restClient.GetWithQueryParams(endpoint, &threshold, map[string]string{
+	client := t.pulsar.restClient.WithOperation("Topics.GetCompactionThreshold")
+	_, err := client.GetWithQueryParams(endpoint, &threshold, map[string]string{
 		"applied": strconv.FormatBool(applied),
 	}, true)
 	return threshold, err
@@ -658,13 +661,13 @@ func (t *topics) GetCompactionThreshold(topic TopicName, applied bool) (int64, e
 
 func (t *topics) SetCompactionThreshold(topic TopicName, threshold int64) error {
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "compactionThreshold")
-	err := t.pulsar.restClient.Post(endpoint, threshold)
+	err := t.pulsar.restClient.WithOperation("Topics.SetCompactionThreshold").Post(endpoint, threshold)
 	return err
 }
 
 func (t *topics) RemoveCompactionThreshold(topic TopicName) error {
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "compactionThreshold")
-	err := t.pulsar.restClient.Delete(endpoint)
+	err := t.pulsar.restClient.WithOperation("Topics.RemoveCompactionThreshold").Delete(endpoint)
 	return err
 }
 
@@ -675,7 +678,8 @@ func (t *topics) GetBacklogQuotaMap(topic TopicName, applied bool) (map[BacklogQ
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "backlogQuotaMap")
 
 	queryParams := map[string]string{"applied": strconv.FormatBool(applied)}
-	_, err := t.pulsar.restClient.GetWithQueryParams(endpoint, &backlogQuotaMap, queryParams, true)
+	client := t.pulsar.restClient.WithOperation("Topics.GetBacklogQuotaMap")
+	_, err := client.GetWithQueryParams(endpoint, &backlogQuotaMap, queryParams, true)
 
 	return backlogQuotaMap, err
 }
@@ -686,12 +690,13 @@ func (t *topics) SetBacklogQuota(topic TopicName, backlogQuota BacklogQuota,
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "backlogQuota")
 	params := make(map[string]string)
 	params["backlogQuotaType"] = string(backlogQuotaType)
-	return t.pulsar.restClient.PostWithQueryParams(endpoint, &backlogQuota, params)
+	return t.pulsar.restClient.WithOperation("Topics.SetBacklogQuota").PostWithQueryParams(endpoint, &backlogQuota, params)
 }
 
 func (t *topics) RemoveBacklogQuota(topic TopicName, backlogQuotaType BacklogQuotaType) error {
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "backlogQuota")
-	return t.pulsar.restClient.DeleteWithQueryParams(endpoint, map[string]string{
+	client := t.pulsar.restClient.WithOperation("Topics.RemoveBacklogQuota")
+	return client.DeleteWithQueryParams(endpoint, map[string]string{
 		"backlogQuotaType": string(backlogQuotaType),
 	})
 }
@@ -699,7 +704,8 @@ func (t *topics) RemoveBacklogQuota(topic TopicName, backlogQuotaType BacklogQuo
 func (t *topics) GetInactiveTopicPolicies(topic TopicName, applied bool) (InactiveTopicPolicies, error) {
 	var out InactiveTopicPolicies
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "inactiveTopicPolicies")
-	_, err := t.pulsar.restClient.GetWithQueryParams(endpoint, &out, map[string]string{
+	client := t.pulsar.restClient.WithOperation("Topics.GetInactiveTopicPolicies")
+	_, err := client.GetWithQueryParams(endpoint, &out, map[string]string{
 		"applied": strconv.FormatBool(applied),
 	}, true)
 	return out, err
@@ -707,22 +713,22 @@ func (t *topics) GetInactiveTopicPolicies(topic TopicName, applied bool) (Inacti
 
 func (t *topics) RemoveInactiveTopicPolicies(topic TopicName) error {
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "inactiveTopicPolicies")
-	return t.pulsar.restClient.Delete(endpoint)
+	return t.pulsar.restClient.WithOperation("Topics.RemoveInactiveTopicPolicies").Delete(endpoint)
 }
 
 func (t *topics) SetInactiveTopicPolicies(topic TopicName, data InactiveTopicPolicies) error {
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "inactiveTopicPolicies")
-	return t.pulsar.restClient.Post(endpoint, data)
+	return t.pulsar.restClient.WithOperation("Topics.SetInactiveTopicPolicies").Post(endpoint, data)
 }
 
 func (t *topics) SetReplicationClusters(topic TopicName, data []string) error {
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "replication")
-	return t.pulsar.restClient.Post(endpoint, data)
+	return t.pulsar.restClient.WithOperation("Topics.SetReplicationClusters").Post(endpoint, data)
 }
 
 func (t *topics) GetReplicationClusters(topic TopicName) ([]string, error) {
 	var data []string
 	endpoint := t.pulsar.endpoint(t.apiVersion, t.basePath, topic.GetRestPath(), "replication")
-	err := t.pulsar.restClient.Get(endpoint, &data)
+	err := t.pulsar.restClient.WithOperation("Topics.GetReplicationClusters").Get(endpoint, &data)
 	return data, err
 }
@@ -16,6 +16,7 @@
 package pulsaradmin
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -29,6 +30,7 @@ const (
 	DefaultWebServiceURL       = "http://localhost:8080"
 	DefaultBKWebServiceURL     = "pulsar://localhost:6650"
 	DefaultHTTPTimeOutDuration = 5 * time.Minute
+	DefaultHealthCheckInterval = 30 * time.Second
 	Product                    = "pulsar-admin-go"
 	ReleaseVersion             = "None"
 	adminBasePath              = `/admin`
@@ -51,6 +53,12 @@ type Client interface {
 	ResourceQuotas() ResourceQuotas
 	FunctionsWorker() FunctionsWorker
 	Packages() Packages
+
+	// WithContext returns a Client whose calls are all bound to ctx, so that
+	// they are aborted once ctx is canceled or its deadline expires. The
+	// returned Client shares its transport and configuration with the
+	// original, which is left untouched.
+	WithContext(ctx context.Context) Client
 }
 
 type pulsarClient struct {
@@ -60,15 +68,28 @@ type pulsarClient struct {
 
 // NewClient returns a new client
 func NewClient(config ClientConfig) (Client, error) {
-	if config.WebServiceURL == "" {
+	if config.WebServiceURL == "" && len(config.WebServiceURLs) == 0 {
 		config.WebServiceURL = DefaultWebServiceURL
 	}
+	if config.HealthCheckInterval == 0 {
+		config.HealthCheckInterval = DefaultHealthCheckInterval
+	}
 	if config.BKWebServiceURL == "" {
 		config.BKWebServiceURL = DefaultBKWebServiceURL
 	}
 	if config.APIProfile == nil {
 		config.APIProfile = defaultAPIProfile()
 	}
+	apiProfile := config.APIProfile.withDefaults()
+	if err := apiProfile.Validate(); err != nil {
+		return nil, err
+	}
+
+	if policy, ok := config.RetryPolicy.(*BackoffRetryPolicy); ok {
+		if err := policy.Validate(); err != nil {
+			return nil, err
+		}
+	}
 
 	baseTransport := config.CustomTransport
 	if baseTransport == nil {
@@ -88,11 +109,62 @@ func NewClient(config ClientConfig) (Client, error) {
 		}
 		clientTransport = authTransport
 	}
+	if config.WrapTransport != nil {
+		clientTransport = config.WrapTransport(clientTransport)
+	}
+
+	serviceURLs := config.WebServiceURLs
+	if config.WebServiceURL != "" {
+		serviceURLs = append([]string{config.WebServiceURL}, serviceURLs...)
+	}
+	restClient := rest.NewClient(clientTransport, serviceURLs[0], Product+`/`+ReleaseVersion)
+	restClient.RetryPolicy = config.RetryPolicy
+	restClient.MaxRedirects = config.MaxRedirects
+	if len(config.Hooks) > 0 {
+		restClient.Interceptor = hooksInterceptor(config.Hooks)
+	}
+	if config.RateLimit != nil || len(config.GroupRateLimits) > 0 {
+		limiter, err := newClientLimiter(config.RateLimit, config.GroupRateLimits)
+		if err != nil {
+			return nil, err
+		}
+		restClient.Limiter = limiter
+	}
 
+	client := &pulsarClient{
+		restClient: restClient,
+		apiProfile: apiProfile,
+	}
+
+	if len(serviceURLs) > 1 {
+		pool, err := rest.NewServiceURLs(serviceURLs, config.HealthCheckInterval)
+		if err != nil {
+			return nil, fmt.Errorf("invalid web service url: %w", err)
+		}
+		pool.Probe = client.healthCheck
+		restClient.ServiceURLs = pool
+	}
+
+	return client, nil
+}
+
+func (c *pulsarClient) WithContext(ctx context.Context) Client {
 	return &pulsarClient{
-		restClient: rest.NewClient(clientTransport, config.WebServiceURL, Product+`/`+ReleaseVersion),
-		apiProfile: APIProfile{},
-	}, nil
+		restClient: c.restClient.WithContext(ctx),
+		apiProfile: c.apiProfile,
+	}
+}
+
+// healthCheck runs a broker health check against the given web service url.
+// It is sent as a plain request, which is neither retried, rate limited nor
+// reported to the hooks.
+func (c *pulsarClient) healthCheck(ctx context.Context, serviceURL string) error {
+	endpoint := c.endpoint(c.apiProfile.Brokers, "/brokers", "/health")
+	buf, err := c.restClient.Probe(ctx, serviceURL, endpoint)
+	if err != nil {
+		return err
+	}
+	return checkHealth(buf)
 }
 
 func (c *pulsarClient) endpoint(apiVersion APIVersion, componentPath string, parts ...string) string {
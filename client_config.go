@@ -15,11 +15,25 @@
 
 package pulsaradmin
 
-import "net/http"
+import (
+	"net/http"
+	"time"
+)
 
 type ClientConfig struct {
 	// the web service url that pulsarctl connects to. Default is http://localhost:8080
 	WebServiceURL string
+	// optional additional web service urls of the same cluster, such as one
+	// per availability zone. Calls fail over to them, in order, when the
+	// connection to WebServiceURL fails. Only connection errors fail over:
+	// a call answered by a broker, even with a 503, is not sent to another
+	// one, and is left to the RetryPolicy.
+	WebServiceURLs []string
+	// the minimum interval between two health checks of an unreachable web
+	// service url. There is no background health check: an unreachable url is
+	// re-probed on demand, when a call is made at least this long after its
+	// last probe. Default is 30s.
+	HealthCheckInterval time.Duration
 	// the bookkeeper service url that pulsarctl connects to.
 	BKWebServiceURL string
 	// TLS Config
@@ -28,7 +42,24 @@ type ClientConfig struct {
 	AuthProvider AuthProvider
 	// optional custom HTTP transport
 	CustomTransport *http.Transport
-	// optional custom API profile to use different versions of different APIs
+	// optional function wrapping the transport used for every admin call,
+	// outside of the auth provider, such as to record or replay the calls
+	WrapTransport func(http.RoundTripper) http.RoundTripper
+	// optional retry policy applied to every admin call. Calls are attempted
+	// only once when it is nil.
+	RetryPolicy RetryPolicy
+	// the maximum number of redirects to the owning broker followed per
+	// attempt. Default is 10, a negative value disables redirects.
+	MaxRedirects int
+	// optional limit applied to all admin calls
+	RateLimit *RateLimit
+	// optional limits applied to the admin calls of a resource group, keyed by
+	// the name of the Client method returning it, such as "Topics"
+	GroupRateLimits map[string]RateLimit
+	// optional hooks notified around every admin call
+	Hooks []Hook
+	// optional custom API profile to use different versions of different APIs.
+	// Resources left undefined use the default version for that resource.
 	APIProfile *APIProfile
 }
 
@@ -83,6 +114,19 @@ type APIProfile struct {
 
 func defaultAPIProfile() *APIProfile {
 	return &APIProfile{
-		Functions: APIV3,
+		Clusters:          APIV2,
+		Functions:         APIV3,
+		Tenants:           APIV2,
+		Topics:            APIV2,
+		Sources:           APIV3,
+		Sinks:             APIV3,
+		Namespaces:        APIV2,
+		Schemas:           APIV2,
+		NsIsolationPolicy: APIV2,
+		Brokers:           APIV2,
+		BrokerStats:       APIV2,
+		ResourceQuotas:    APIV2,
+		FunctionsWorker:   APIV2,
+		Packages:          APIV3,
 	}
 }
@@ -0,0 +1,328 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsaradmin
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/streamnative/pulsar-admin-go/internal/localuser"
+)
+
+// The following environment variables are read by ClientConfigFromEnv.
+const (
+	// EnvClientConf is the path of a client.conf or pulsarctl config file.
+	EnvClientConf = "PULSAR_CLIENT_CONF"
+	// EnvContext is the pulsarctl context to use.
+	EnvContext = "PULSAR_CONTEXT"
+	// EnvPulsarctlConfig overrides the path of the pulsarctl config file.
+	EnvPulsarctlConfig = "PULSARCONFIG"
+
+	EnvWebServiceURL                 = "PULSAR_WEB_SERVICE_URL"
+	EnvAuthPlugin                    = "PULSAR_AUTH_PLUGIN"
+	EnvAuthParams                    = "PULSAR_AUTH_PARAMS"
+	EnvTLSTrustCertsFilePath         = "PULSAR_TLS_TRUST_CERTS_FILE_PATH"
+	EnvTLSAllowInsecureConnection    = "PULSAR_TLS_ALLOW_INSECURE_CONNECTION"
+	EnvTLSEnableHostnameVerification = "PULSAR_TLS_ENABLE_HOSTNAME_VERIFICATION"
+)
+
+// ClientConfigFromFile reads a client config from either a Pulsar client.conf
+// properties file, or a pulsarctl config file in which case the current
+// context is used.
+func ClientConfigFromFile(path string) (ClientConfig, error) {
+	config, props, err := readClientConfigFile(path)
+	if err != nil || props == nil {
+		return config, err
+	}
+	err = applyProperties(&config, props)
+	return config, err
+}
+
+// readClientConfigFile reads the config of a pulsarctl config file, or else
+// the properties of a client.conf file.
+func readClientConfigFile(path string) (ClientConfig, map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ClientConfig{}, nil, err
+	}
+	if isPulsarctlConfig(data) {
+		config, err := clientConfigFromPulsarctl(path, data, "")
+		return config, nil, err
+	}
+	props, err := parseProperties(data)
+	if err != nil {
+		return ClientConfig{}, nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return ClientConfig{}, props, nil
+}
+
+// ClientConfigFromContext reads a client config from a context of the
+// pulsarctl config file, located at $PULSARCONFIG or by default at
+// ~/.config/pulsar/config. The current context is used if contextName is
+// empty.
+func ClientConfigFromContext(contextName string) (ClientConfig, error) {
+	path := PulsarctlConfigPath()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ClientConfig{}, err
+	}
+	return clientConfigFromPulsarctl(path, data, contextName)
+}
+
+// PulsarctlConfigPath returns the path of the pulsarctl config file.
+func PulsarctlConfigPath() string {
+	if path := os.Getenv(EnvPulsarctlConfig); path != "" {
+		return path
+	}
+	return filepath.Join(localuser.HomeDir(), ".config", "pulsar", "config")
+}
+
+// ClientConfigFromEnv reads a client config from the environment. The file
+// named by PULSAR_CLIENT_CONF, or else the pulsarctl context named by
+// PULSAR_CONTEXT, is read first; the PULSAR_WEB_SERVICE_URL, PULSAR_AUTH_*
+// and PULSAR_TLS_* variables then override the values read from it. The
+// properties of a client.conf file are overridden one by one, so that
+// PULSAR_AUTH_PLUGIN or PULSAR_AUTH_PARAMS alone keeps the other one from
+// the file.
+func ClientConfigFromEnv() (ClientConfig, error) {
+	var config ClientConfig
+	var props map[string]string
+	var err error
+	switch {
+	case os.Getenv(EnvClientConf) != "":
+		config, props, err = readClientConfigFile(os.Getenv(EnvClientConf))
+	case os.Getenv(EnvContext) != "":
+		config, err = ClientConfigFromContext(os.Getenv(EnvContext))
+	}
+	if err != nil {
+		return ClientConfig{}, err
+	}
+
+	if props == nil {
+		props = make(map[string]string)
+	}
+	for key, env := range map[string]string{
+		"webServiceUrl":                 EnvWebServiceURL,
+		"authPlugin":                    EnvAuthPlugin,
+		"authParams":                    EnvAuthParams,
+		"tlsTrustCertsFilePath":         EnvTLSTrustCertsFilePath,
+		"tlsAllowInsecureConnection":    EnvTLSAllowInsecureConnection,
+		"tlsEnableHostnameVerification": EnvTLSEnableHostnameVerification,
+	} {
+		if value, ok := os.LookupEnv(env); ok {
+			props[key] = value
+		}
+	}
+	if err := applyProperties(&config, props); err != nil {
+		return ClientConfig{}, fmt.Errorf("environment: %w", err)
+	}
+	return config, nil
+}
+
+// applyProperties sets the fields of config from client.conf properties.
+func applyProperties(config *ClientConfig, props map[string]string) error {
+	if v, ok := props["webServiceUrl"]; ok {
+		config.WebServiceURL = v
+	}
+	if v, ok := props["tlsTrustCertsFilePath"]; ok {
+		config.TLSConfig.TrustCertsFilePath = v
+	}
+	for key, field := range map[string]*bool{
+		"tlsAllowInsecureConnection":    &config.TLSConfig.AllowInsecureConnection,
+		"tlsEnableHostnameVerification": &config.TLSConfig.EnableHostnameVerification,
+	} {
+		v, ok := props[key]
+		if !ok || v == "" {
+			continue
+		}
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", key, err)
+		}
+		*field = b
+	}
+	switch plugin, params := props["authPlugin"], props["authParams"]; {
+	case plugin != "":
+		config.AuthProvider = AuthProviderPlugin(plugin, params)
+	case params != "":
+		return errors.New("authParams is set without authPlugin")
+	}
+	return nil
+}
+
+// parseProperties parses the subset of the Java properties format used by
+// client.conf: key=value or key:value pairs, comments starting with # or !,
+// and values continued on the next line with a trailing backslash.
+func parseProperties(data []byte) (map[string]string, error) {
+	props := make(map[string]string)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	var logical strings.Builder
+	for scanner.Scan() {
+		line := strings.TrimLeft(scanner.Text(), " \t\f")
+		if logical.Len() == 0 && (line == "" || line[0] == '#' || line[0] == '!') {
+			continue
+		}
+		if strings.HasSuffix(line, `\`) && !strings.HasSuffix(line, `\\`) {
+			logical.WriteString(strings.TrimSuffix(line, `\`))
+			continue
+		}
+		logical.WriteString(line)
+		key, value := splitProperty(logical.String())
+		props[key] = value
+		logical.Reset()
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if logical.Len() > 0 {
+		key, value := splitProperty(logical.String())
+		props[key] = value
+	}
+	return props, nil
+}
+
+func splitProperty(line string) (string, string) {
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case '\\':
+			i++
+		case '=', ':', ' ', '\t':
+			key := unescapeProperty(line[:i])
+			value := strings.TrimLeft(line[i:], " \t")
+			if value != "" && (value[0] == '=' || value[0] == ':') {
+				value = strings.TrimLeft(value[1:], " \t")
+			}
+			return key, unescapeProperty(strings.TrimRight(value, " \t"))
+		}
+	}
+	return unescapeProperty(line), ""
+}
+
+func unescapeProperty(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			i++
+			switch s[i] {
+			case 't':
+				b.WriteByte('\t')
+			case 'n':
+				b.WriteByte('\n')
+			case 'r':
+				b.WriteByte('\r')
+			default:
+				b.WriteByte(s[i])
+			}
+			continue
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+// pulsarctlConfig is the content of a pulsarctl config file.
+type pulsarctlConfig struct {
+	AuthInfos      map[string]*pulsarctlAuthInfo `yaml:"auth-info"`
+	Contexts       map[string]*pulsarctlContext  `yaml:"contexts"`
+	CurrentContext string                        `yaml:"current-context"`
+}
+
+type pulsarctlContext struct {
+	WebServiceURL   string `yaml:"admin-service-url"`
+	BKWebServiceURL string `yaml:"bookie-service-url"`
+}
+
+type pulsarctlAuthInfo struct {
+	TLSTrustCertsFilePath      string `yaml:"tls_trust_certs_file_path"`
+	TLSAllowInsecureConnection bool   `yaml:"tls_allow_insecure_connection"`
+	TLSEnableHostnameVerify    bool   `yaml:"tls_enable_hostname_verification"`
+	TLSCertFile                string `yaml:"tls_cert_file"`
+	TLSKeyFile                 string `yaml:"tls_key_file"`
+	Token                      string `yaml:"token"`
+	TokenFile                  string `yaml:"tokenFile"`
+	IssuerEndpoint             string `yaml:"issuer_endpoint"`
+	ClientID                   string `yaml:"client_id"`
+	Audience                   string `yaml:"audience"`
+	Scope                      string `yaml:"scope"`
+	KeyFile                    string `yaml:"key_file"`
+}
+
+func isPulsarctlConfig(data []byte) bool {
+	var config pulsarctlConfig
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return false
+	}
+	return config.Contexts != nil || config.CurrentContext != ""
+}
+
+func clientConfigFromPulsarctl(path string, data []byte, contextName string) (ClientConfig, error) {
+	var file pulsarctlConfig
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return ClientConfig{}, fmt.Errorf("%s: %w", path, err)
+	}
+	if contextName == "" {
+		contextName = file.CurrentContext
+	}
+	if contextName == "" {
+		return ClientConfig{}, fmt.Errorf("%s: no current context is set", path)
+	}
+	ctx, ok := file.Contexts[contextName]
+	if !ok || ctx == nil {
+		return ClientConfig{}, fmt.Errorf("%s: context %q not found", path, contextName)
+	}
+
+	config := ClientConfig{
+		WebServiceURL:   ctx.WebServiceURL,
+		BKWebServiceURL: ctx.BKWebServiceURL,
+	}
+	auth := file.AuthInfos[contextName]
+	if auth == nil {
+		return config, nil
+	}
+	config.TLSConfig = TLSConfig{
+		TrustCertsFilePath:         auth.TLSTrustCertsFilePath,
+		AllowInsecureConnection:    auth.TLSAllowInsecureConnection,
+		EnableHostnameVerification: auth.TLSEnableHostnameVerify,
+	}
+	switch {
+	case auth.IssuerEndpoint != "":
+		config.AuthProvider = AuthProviderOAuth2(AuthParamsOAuth2{
+			IssuerURL:  auth.IssuerEndpoint,
+			ClientID:   auth.ClientID,
+			Audience:   auth.Audience,
+			Scope:      auth.Scope,
+			PrivateKey: auth.KeyFile,
+		})
+	case auth.Token != "":
+		config.AuthProvider = AuthProviderToken(auth.Token)
+	case auth.TokenFile != "":
+		config.AuthProvider = AuthProviderTokenFile(auth.TokenFile)
+	case auth.TLSCertFile != "" || auth.TLSKeyFile != "":
+		config.AuthProvider = AuthProviderTLS(auth.TLSCertFile, auth.TLSKeyFile)
+	}
+	return config, nil
+}
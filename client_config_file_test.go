@@ -0,0 +1,177 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsaradmin
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/streamnative/pulsar-admin-go/internal/auth"
+)
+
+const testClientConf = `# Pulsar client configuration
+webServiceUrl=https://pulsar.example.com:8443/
+brokerServiceUrl=pulsar+ssl://pulsar.example.com:6651/
+! the token is split across lines
+authPlugin=org.apache.pulsar.client.impl.auth.AuthenticationToken
+authParams=token:abc\
+  def
+tlsAllowInsecureConnection = true
+tlsEnableHostnameVerification: false
+tlsTrustCertsFilePath=/etc/pulsar/ca.pem
+`
+
+const testPulsarctlConfig = `auth-info:
+  prod:
+    locationOfOrigin: /home/user/.config/pulsar/config
+    tls_trust_certs_file_path: /etc/pulsar/prod-ca.pem
+    tls_allow_insecure_connection: false
+    token: prod-token
+    tokenFile: ""
+    issuer_endpoint: ""
+  staging:
+    tls_allow_insecure_connection: true
+    issuer_endpoint: https://auth.example.com
+    client_id: my-client
+    audience: urn:pulsar
+    key_file: /etc/pulsar/key.json
+contexts:
+  prod:
+    admin-service-url: https://prod.example.com:8443
+    bookie-service-url: http://prod.example.com:8000
+  staging:
+    admin-service-url: https://staging.example.com:8443
+  local:
+    admin-service-url: http://localhost:8080
+current-context: prod
+`
+
+func writeTestFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestClientConfigFromClientConf(t *testing.T) {
+	config, err := ClientConfigFromFile(writeTestFile(t, "client.conf", testClientConf))
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://pulsar.example.com:8443/", config.WebServiceURL)
+	assert.Equal(t, TLSConfig{
+		TrustCertsFilePath:      "/etc/pulsar/ca.pem",
+		AllowInsecureConnection: true,
+	}, config.TLSConfig)
+	require.NotNil(t, config.AuthProvider)
+
+	transport, err := config.AuthProvider(&http.Transport{})
+	require.NoError(t, err)
+	require.IsType(t, &auth.TokenTransport{}, transport)
+	assert.Equal(t, "abcdef", transport.(*auth.TokenTransport).Token)
+}
+
+func TestClientConfigFromPulsarctlFile(t *testing.T) {
+	config, err := ClientConfigFromFile(writeTestFile(t, "config", testPulsarctlConfig))
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://prod.example.com:8443", config.WebServiceURL)
+	assert.Equal(t, "http://prod.example.com:8000", config.BKWebServiceURL)
+	assert.Equal(t, "/etc/pulsar/prod-ca.pem", config.TLSConfig.TrustCertsFilePath)
+	transport, err := config.AuthProvider(&http.Transport{})
+	require.NoError(t, err)
+	assert.Equal(t, "prod-token", transport.(*auth.TokenTransport).Token)
+}
+
+func TestClientConfigFromContext(t *testing.T) {
+	t.Setenv(EnvPulsarctlConfig, writeTestFile(t, "config", testPulsarctlConfig))
+
+	config, err := ClientConfigFromContext("staging")
+	require.NoError(t, err)
+	assert.Equal(t, "https://staging.example.com:8443", config.WebServiceURL)
+	assert.True(t, config.TLSConfig.AllowInsecureConnection)
+	assert.NotNil(t, config.AuthProvider)
+
+	config, err = ClientConfigFromContext("local")
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:8080", config.WebServiceURL)
+	assert.Nil(t, config.AuthProvider)
+
+	_, err = ClientConfigFromContext("missing")
+	assert.Error(t, err)
+}
+
+func TestClientConfigFromEnv(t *testing.T) {
+	t.Setenv(EnvClientConf, writeTestFile(t, "client.conf", testClientConf))
+	t.Setenv(EnvWebServiceURL, "https://override.example.com:8443")
+	t.Setenv(EnvAuthPlugin, AuthPluginToken)
+	t.Setenv(EnvAuthParams, "token:from-env")
+	t.Setenv(EnvTLSAllowInsecureConnection, "false")
+
+	config, err := ClientConfigFromEnv()
+	require.NoError(t, err)
+	assert.Equal(t, "https://override.example.com:8443", config.WebServiceURL)
+	assert.Equal(t, "/etc/pulsar/ca.pem", config.TLSConfig.TrustCertsFilePath)
+	assert.False(t, config.TLSConfig.AllowInsecureConnection)
+	transport, err := config.AuthProvider(&http.Transport{})
+	require.NoError(t, err)
+	assert.Equal(t, "from-env", transport.(*auth.TokenTransport).Token)
+
+	t.Setenv(EnvTLSAllowInsecureConnection, "maybe")
+	_, err = ClientConfigFromEnv()
+	assert.Error(t, err)
+}
+
+func TestClientConfigFromEnvMergesAuth(t *testing.T) {
+	token := func(t *testing.T) string {
+		t.Helper()
+		config, err := ClientConfigFromEnv()
+		require.NoError(t, err)
+		require.NotNil(t, config.AuthProvider)
+		transport, err := config.AuthProvider(&http.Transport{})
+		require.NoError(t, err)
+		return transport.(*auth.TokenTransport).Token
+	}
+
+	t.Run("plugin only", func(t *testing.T) {
+		t.Setenv(EnvClientConf, writeTestFile(t, "client.conf", testClientConf))
+		t.Setenv(EnvAuthPlugin, AuthPluginToken)
+		assert.Equal(t, "abcdef", token(t))
+	})
+
+	t.Run("params only", func(t *testing.T) {
+		t.Setenv(EnvClientConf, writeTestFile(t, "client.conf", testClientConf))
+		t.Setenv(EnvAuthParams, "token:from-env")
+		assert.Equal(t, "from-env", token(t))
+	})
+
+	t.Run("params without plugin", func(t *testing.T) {
+		t.Setenv(EnvPulsarctlConfig, writeTestFile(t, "config", testPulsarctlConfig))
+		t.Setenv(EnvContext, "local")
+		t.Setenv(EnvAuthParams, "token:from-env")
+		_, err := ClientConfigFromEnv()
+		assert.ErrorContains(t, err, "authParams is set without authPlugin")
+	})
+}
+
+func TestParseProperties(t *testing.T) {
+	props, err := parseProperties([]byte("a=1\nb : 2\nc 3\n  # comment\nd=x\\:y\\\n   z\ne\n"))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"a": "1", "b": "2", "c": "3", "d": "x:yz", "e": ""}, props)
+}
@@ -0,0 +1,127 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsaradmin
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientFailsOverToHealthyWebServiceURL(t *testing.T) {
+	var primaryCalls, secondaryCalls int32
+	handler := func(calls *int32) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/admin/v2/brokers/health" {
+				_, _ = w.Write([]byte("ok"))
+				return
+			}
+			atomic.AddInt32(calls, 1)
+			_, _ = w.Write([]byte(`["public"]`))
+		})
+	}
+
+	// the primary is down when the client starts
+	primary := httptest.NewServer(handler(&primaryCalls))
+	primaryAddr := primary.Listener.Addr().String()
+	primaryURL := primary.URL
+	primary.Close()
+
+	secondary := httptest.NewServer(handler(&secondaryCalls))
+	defer secondary.Close()
+
+	client, err := NewClient(ClientConfig{
+		WebServiceURL:       primaryURL,
+		WebServiceURLs:      []string{secondary.URL},
+		HealthCheckInterval: 10 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	pool := client.(*pulsarClient).restClient.ServiceURLs
+
+	tenants, err := client.Tenants().List()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"public"}, tenants)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&secondaryCalls))
+	assert.Equal(t, []string{secondary.URL}, pool.Healthy())
+
+	// once the primary is back, a health check marks it healthy again
+	listener, err := net.Listen("tcp", primaryAddr)
+	require.NoError(t, err)
+	primary = &httptest.Server{Listener: listener, Config: &http.Server{Handler: handler(&primaryCalls)}}
+	primary.Start()
+	defer primary.Close()
+
+	require.Eventually(t, func() bool {
+		_, err := client.Tenants().List()
+		require.NoError(t, err)
+		return len(pool.Healthy()) == 2
+	}, time.Second, 20*time.Millisecond)
+
+	before := atomic.LoadInt32(&primaryCalls)
+	_, err = client.Tenants().List()
+	require.NoError(t, err)
+	assert.Equal(t, before+1, atomic.LoadInt32(&primaryCalls))
+}
+
+func TestClientFailoverGivesUpWhenAllURLsAreDown(t *testing.T) {
+	first := httptest.NewServer(http.NotFoundHandler())
+	first.Close()
+	second := httptest.NewServer(http.NotFoundHandler())
+	second.Close()
+
+	client, err := NewClient(ClientConfig{WebServiceURLs: []string{first.URL, second.URL}})
+	require.NoError(t, err)
+
+	_, err = client.Tenants().List()
+	require.Error(t, err)
+	assert.True(t, IsRetryable(err))
+}
+
+func TestClientHealthCheckBypassesRetriesAndHooks(t *testing.T) {
+	var probes int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/admin/v2/brokers/health", r.URL.Path)
+		atomic.AddInt32(&probes, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	var hooked int32
+	client, err := NewClient(ClientConfig{
+		WebServiceURLs: []string{server.URL, server.URL},
+		RetryPolicy:    &BackoffRetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond},
+		RateLimit:      &RateLimit{MaxInFlight: 1},
+		Hooks: []Hook{HookFuncs{
+			Before: func(ctx context.Context, req *RequestInfo) context.Context {
+				atomic.AddInt32(&hooked, 1)
+				return ctx
+			},
+		}},
+	})
+	require.NoError(t, err)
+
+	err = client.(*pulsarClient).healthCheck(context.Background(), server.URL)
+	require.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&probes))
+	assert.Zero(t, atomic.LoadInt32(&hooked))
+}
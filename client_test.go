@@ -16,8 +16,11 @@
 package pulsaradmin
 
 import (
+	"context"
 	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -107,3 +110,71 @@ func TestNewWithTlsAllowInsecure(t *testing.T) {
 	require.NotNil(t, tr.TLSClientConfig)
 	require.True(t, tr.TLSClientConfig.InsecureSkipVerify)
 }
+
+func TestClientWithContextCancelsInFlightCalls(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{WebServiceURL: server.URL})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err = client.WithContext(ctx).Tenants().List()
+	require.Error(t, err)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestClientWithContextLeavesOriginalUntouched(t *testing.T) {
+	client, err := NewClient(ClientConfig{})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	bound := client.WithContext(ctx).(*pulsarClient)
+	require.Equal(t, ctx, bound.restClient.Context())
+	require.Equal(t, context.Background(), client.(*pulsarClient).restClient.Context())
+	require.Equal(t, client.(*pulsarClient).restClient.HTTPClient, bound.restClient.HTTPClient)
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestNewClientWrapTransportWrapsAuthProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`["public"]`))
+	}))
+	defer server.Close()
+
+	var wrapped []string
+	client, err := NewClient(ClientConfig{
+		WebServiceURL: server.URL,
+		AuthProvider:  AuthProviderToken("token"),
+		WrapTransport: func(next http.RoundTripper) http.RoundTripper {
+			return roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				assert.Empty(t, req.Header.Get("Authorization"))
+				wrapped = append(wrapped, req.URL.Path)
+				return next.RoundTrip(req)
+			})
+		},
+	})
+	require.NoError(t, err)
+
+	tenants, err := client.Tenants().List()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"public"}, tenants)
+	assert.Equal(t, []string{"/admin/v2/tenants"}, wrapped)
+}
@@ -1,8 +1,14 @@
 package pulsaradmin
 
 import (
+	"context"
 	"errors"
+	"io"
+	"net"
 	"net/http"
+	"syscall"
+
+	"github.com/streamnative/pulsar-admin-go/internal/rest"
 )
 
 // APIErr is an error described in the Pulsar Admin API contract with a defined reson.
@@ -20,16 +26,104 @@ type ServerErr interface {
 	Code() int
 }
 
+// Error is returned by every admin call that receives an unsuccessful
+// response. It carries the HTTP method and endpoint path of the request along
+// with the status code and reason returned by the broker, and wraps either an
+// APIErr or a ServerErr. Use errors.As to retrieve it:
+//
+//	var adminErr *pulsaradmin.Error
+//	if errors.As(err, &adminErr) {
+//		log.Printf("%s %s: %d", adminErr.Method, adminErr.Endpoint, adminErr.StatusCode)
+//	}
+//
+// Since the admin calls return an *Error, a type assertion of the returned
+// error, such as err.(APIErr) or err.(ServerErr), no longer succeeds. Use
+// errors.As, which finds the wrapped APIErr or ServerErr:
+//
+//	var apiErr pulsaradmin.APIErr
+//	if errors.As(err, &apiErr) {
+//		log.Printf("%d: %s", apiErr.Code(), apiErr.Reason())
+//	}
+type Error = rest.Error
+
+// ErrPackageChecksumMismatch is returned when a downloaded package does not
+// match the checksum recorded in its metadata.
+var ErrPackageChecksumMismatch = errors.New("package checksum mismatch")
+
+// ErrPackageChecksumMissing is returned when a package is to be verified but
+// its metadata records no checksum.
+var ErrPackageChecksumMissing = errors.New("package checksum missing")
+
 type codedErr interface {
 	Code() int
 }
 
+func hasCode(err error, code int) bool {
+	var ce codedErr
+	if errors.As(err, &ce) {
+		return ce.Code() == code
+	}
+	return false
+}
+
 // IsNotFound will return true if the error represents a Pulsar resource that
 // does not exist.
 func IsNotFound(err error) bool {
+	return hasCode(err, http.StatusNotFound)
+}
+
+// IsConflict will return true if the error represents a Pulsar resource that
+// already exists, or a concurrent modification of it.
+func IsConflict(err error) bool {
+	return hasCode(err, http.StatusConflict)
+}
+
+// IsPreconditionFailed will return true if the broker rejected the request
+// because a precondition did not hold, such as a namespace that is not empty
+// or a policy that is not set at the requested level.
+func IsPreconditionFailed(err error) bool {
+	return hasCode(err, http.StatusPreconditionFailed)
+}
+
+// IsUnauthorized will return true if the request was not authenticated.
+func IsUnauthorized(err error) bool {
+	return hasCode(err, http.StatusUnauthorized)
+}
+
+// IsForbidden will return true if the authenticated role is not allowed to
+// perform the request.
+func IsForbidden(err error) bool {
+	return hasCode(err, http.StatusForbidden)
+}
+
+// IsTooManyRequests will return true if the broker throttled the request.
+func IsTooManyRequests(err error) bool {
+	return hasCode(err, http.StatusTooManyRequests)
+}
+
+// IsRetryable will return true if the error is likely transient, so that the
+// same request may succeed later: throttling, unavailable or timed out
+// brokers, and network errors. Errors caused by a canceled or expired context
+// are never retryable.
+func IsRetryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
 	var ce codedErr
 	if errors.As(err, &ce) {
-		return ce.Code() == http.StatusNotFound
+		switch ce.Code() {
+		case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout:
+			return true
+		}
+		return false
 	}
-	return false
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+	return errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED)
 }
@@ -0,0 +1,113 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsaradmin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorCarriesRequestAndReason(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusConflict)
+		_, _ = w.Write([]byte(`{"reason":"Tenant already exists"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{WebServiceURL: server.URL})
+	require.NoError(t, err)
+
+	err = client.Tenants().Create(TenantData{Name: "tenant"})
+	require.Error(t, err)
+	assert.Equal(t, "Tenant already exists", err.Error())
+	assert.True(t, IsConflict(err))
+	assert.False(t, IsNotFound(err))
+	assert.False(t, IsRetryable(err))
+
+	var adminErr *Error
+	require.True(t, errors.As(err, &adminErr))
+	assert.Equal(t, http.MethodPut, adminErr.Method)
+	assert.Equal(t, "/admin/v2/tenants/tenant", adminErr.Endpoint)
+	assert.Equal(t, http.StatusConflict, adminErr.StatusCode)
+	assert.Equal(t, "Tenant already exists", adminErr.Reason)
+
+	var apiErr APIErr
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusConflict, apiErr.Code())
+
+	// the returned error wraps the APIErr, and no longer is one
+	_, ok := err.(APIErr) //nolint:errorlint
+	assert.False(t, ok)
+}
+
+func TestErrorWithoutReason(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte("broker is starting"))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{WebServiceURL: server.URL})
+	require.NoError(t, err)
+
+	_, err = client.Tenants().List()
+	require.Error(t, err)
+	assert.True(t, IsRetryable(err))
+
+	var adminErr *Error
+	require.True(t, errors.As(err, &adminErr))
+	assert.Empty(t, adminErr.Reason)
+	assert.Equal(t, "broker is starting", adminErr.Response)
+
+	var apiErr APIErr
+	assert.False(t, errors.As(err, &apiErr))
+
+	var serverErr ServerErr
+	require.True(t, errors.As(err, &serverErr))
+	assert.Equal(t, http.StatusServiceUnavailable, serverErr.Code())
+	_, ok := err.(ServerErr) //nolint:errorlint
+	assert.False(t, ok)
+}
+
+func TestErrorStatusChecks(t *testing.T) {
+	coded := func(code int) error {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(code)
+		}))
+		defer server.Close()
+		client, err := NewClient(ClientConfig{WebServiceURL: server.URL})
+		require.NoError(t, err)
+		_, err = client.Tenants().List()
+		return fmt.Errorf("wrapped: %w", err)
+	}
+
+	assert.True(t, IsUnauthorized(coded(http.StatusUnauthorized)))
+	assert.True(t, IsForbidden(coded(http.StatusForbidden)))
+	assert.True(t, IsPreconditionFailed(coded(http.StatusPreconditionFailed)))
+	assert.True(t, IsTooManyRequests(coded(http.StatusTooManyRequests)))
+	assert.True(t, IsRetryable(coded(http.StatusTooManyRequests)))
+	assert.False(t, IsRetryable(coded(http.StatusInternalServerError)))
+	assert.False(t, IsRetryable(context.Canceled))
+	assert.False(t, IsRetryable(nil))
+}
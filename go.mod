@@ -6,9 +6,16 @@ require (
 	github.com/99designs/keyring v1.2.1
 	github.com/apache/pulsar-client-go v0.9.0
 	github.com/golang/protobuf v1.5.2
+	github.com/golang/snappy v0.0.4
+	github.com/klauspost/compress v1.15.15
+	github.com/linkedin/goavro/v2 v2.12.0
+	github.com/pierrec/lz4/v4 v4.1.17
 	github.com/pkg/errors v0.9.1
-	github.com/stretchr/testify v1.8.0
+	github.com/stretchr/testify v1.8.2
 	golang.org/x/oauth2 v0.0.0-20210402161424-2e8d93401602
+	golang.org/x/time v0.3.0
+	google.golang.org/protobuf v1.26.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -18,15 +25,15 @@ require (
 	github.com/dvsekhvalnov/jose2go v1.5.0 // indirect
 	github.com/godbus/dbus v0.0.0-20190726142602-4481cbc300e2 // indirect
 	github.com/golang-jwt/jwt v3.2.1+incompatible // indirect
+	github.com/google/go-cmp v0.5.9 // indirect
 	github.com/gsterjov/go-libsecret v0.0.0-20161001094733-a6f4afe4910c // indirect
 	github.com/mtibben/percent v0.2.1 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/stretchr/objx v0.5.0 // indirect
 	golang.org/x/net v0.7.0 // indirect
 	golang.org/x/sys v0.5.0 // indirect
 	golang.org/x/term v0.5.0 // indirect
 	google.golang.org/appengine v1.6.7 // indirect
-	google.golang.org/protobuf v1.26.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
 
 replace golang.org/x/sys => golang.org/x/sys v0.0.0-20220422013727-9388b58f7150
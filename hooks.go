@@ -0,0 +1,138 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsaradmin
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/streamnative/pulsar-admin-go/internal/rest"
+)
+
+// RequestInfo describes an admin call about to be made.
+type RequestInfo struct {
+	// Operation is the name of the admin method that issued the call, such as
+	// "Topics.SetRetention".
+	Operation string
+	// Method is the HTTP method of the call.
+	Method string
+	// Endpoint is the path of the call, such as
+	// "/admin/v2/namespaces/public/default/retention".
+	Endpoint string
+	// Header holds extra headers sent with every attempt of the call. Hooks
+	// may add to it, for instance to propagate a trace context.
+	Header http.Header
+}
+
+// ResponseInfo describes the outcome of an admin call.
+type ResponseInfo struct {
+	// StatusCode is the HTTP status code of the last response received, or
+	// zero if none was.
+	StatusCode int
+	// Latency is the time spent until the response headers were received,
+	// including retries and redirects, and the time spent waiting for the
+	// rate limits of ClientConfig.RateLimit and ClientConfig.GroupRateLimits.
+	Latency time.Duration
+	// Err is the error returned by the call, if any.
+	Err error
+}
+
+// Hook observes the admin calls made by a client. Hooks are set with
+// ClientConfig.Hooks; BeforeRequest is called on each hook in order before
+// every call, and AfterRequest in reverse order once the call completes.
+type Hook interface {
+	// BeforeRequest is called before the call is made. The returned context
+	// is used for the call and passed to AfterRequest; it must not be nil.
+	BeforeRequest(ctx context.Context, req *RequestInfo) context.Context
+	// AfterRequest is called once the call completes.
+	AfterRequest(ctx context.Context, req *RequestInfo, resp *ResponseInfo)
+}
+
+// HookFuncs adapts a pair of functions to the Hook interface. Either function
+// may be nil.
+type HookFuncs struct {
+	Before func(ctx context.Context, req *RequestInfo) context.Context
+	After  func(ctx context.Context, req *RequestInfo, resp *ResponseInfo)
+}
+
+var _ Hook = HookFuncs{}
+
+// BeforeRequest implements Hook.
+func (h HookFuncs) BeforeRequest(ctx context.Context, req *RequestInfo) context.Context {
+	if h.Before == nil {
+		return ctx
+	}
+	return h.Before(ctx, req)
+}
+
+// AfterRequest implements Hook.
+func (h HookFuncs) AfterRequest(ctx context.Context, req *RequestInfo, resp *ResponseInfo) {
+	if h.After != nil {
+		h.After(ctx, req, resp)
+	}
+}
+
+// hooksInterceptor chains hooks into an interceptor for the REST client.
+func hooksInterceptor(hooks []Hook) rest.Interceptor {
+	return func(ctx context.Context, call *rest.Call) (context.Context, func(int, error)) {
+		req := &RequestInfo{
+			Operation: call.Operation,
+			Method:    call.Method,
+			Endpoint:  call.Endpoint,
+			Header:    call.Header,
+		}
+		contexts := make([]context.Context, len(hooks))
+		for i, hook := range hooks {
+			ctx = hook.BeforeRequest(ctx, req)
+			contexts[i] = ctx
+		}
+		start := time.Now()
+
+		return ctx, func(statusCode int, err error) {
+			resp := &ResponseInfo{
+				StatusCode: statusCode,
+				Latency:    time.Since(start),
+				Err:        err,
+			}
+			for i := len(hooks) - 1; i >= 0; i-- {
+				hooks[i].AfterRequest(contexts[i], req, resp)
+			}
+		}
+	}
+}
+
+// operationGroups maps the types implementing the admin interfaces to the
+// name of the Client method returning them, which prefixes the names of
+// their operations.
+var operationGroups = map[string]string{
+	"pulsarClient":      "Client",
+	"brokerStats":       "BrokerStats",
+	"broker":            "Brokers",
+	"clusters":          "Clusters",
+	"functions":         "Functions",
+	"worker":            "FunctionsWorker",
+	"namespaces":        "Namespaces",
+	"nsIsolationPolicy": "NsIsolationPolicy",
+	"packages":          "Packages",
+	"resource":          "ResourceQuotas",
+	"schemas":           "Schemas",
+	"sinks":             "Sinks",
+	"sources":           "Sources",
+	"subscriptions":     "Subscriptions",
+	"tenants":           "Tenants",
+	"topics":            "Topics",
+}
@@ -0,0 +1,45 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build go1.21
+
+package pulsaradmin
+
+import (
+	"context"
+	"log/slog"
+)
+
+// SlogHook returns a Hook that logs every admin call to logger: successful
+// calls at debug level and failed ones at error level.
+func SlogHook(logger *slog.Logger) Hook {
+	return HookFuncs{
+		After: func(ctx context.Context, req *RequestInfo, resp *ResponseInfo) {
+			attrs := []slog.Attr{
+				slog.String("operation", req.Operation),
+				slog.String("method", req.Method),
+				slog.String("endpoint", req.Endpoint),
+				slog.Int("status", resp.StatusCode),
+				slog.Duration("latency", resp.Latency),
+			}
+			if resp.Err != nil {
+				attrs = append(attrs, slog.String("error", resp.Err.Error()))
+				logger.LogAttrs(ctx, slog.LevelError, "pulsar admin call failed", attrs...)
+				return
+			}
+			logger.LogAttrs(ctx, slog.LevelDebug, "pulsar admin call", attrs...)
+		},
+	}
+}
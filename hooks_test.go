@@ -0,0 +1,188 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsaradmin
+
+import (
+	"context"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type hookKey struct{}
+
+func TestHooksObserveCalls(t *testing.T) {
+	var header string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Get("X-Hook")
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	var order []string
+	var requests []RequestInfo
+	var responses []ResponseInfo
+	outer := HookFuncs{
+		Before: func(ctx context.Context, req *RequestInfo) context.Context {
+			order = append(order, "outer.before")
+			req.Header.Set("X-Hook", "set-by-hook")
+			return context.WithValue(ctx, hookKey{}, "outer")
+		},
+		After: func(ctx context.Context, req *RequestInfo, resp *ResponseInfo) {
+			order = append(order, "outer.after")
+			assert.Equal(t, "outer", ctx.Value(hookKey{}))
+			requests = append(requests, *req)
+			responses = append(responses, *resp)
+		},
+	}
+	inner := HookFuncs{
+		Before: func(ctx context.Context, req *RequestInfo) context.Context {
+			order = append(order, "inner.before")
+			assert.Equal(t, "outer", ctx.Value(hookKey{}))
+			return ctx
+		},
+		After: func(ctx context.Context, req *RequestInfo, resp *ResponseInfo) {
+			order = append(order, "inner.after")
+		},
+	}
+
+	client, err := NewClient(ClientConfig{WebServiceURL: server.URL, Hooks: []Hook{outer, inner}})
+	require.NoError(t, err)
+
+	ns, err := GetNamespaceName("public/default")
+	require.NoError(t, err)
+	require.NoError(t, client.Namespaces().SetRetention(ns.String(), RetentionPolicies{}))
+	topic, err := GetTopicName("public/default/test")
+	require.NoError(t, err)
+	err = client.Subscriptions().Delete(*topic, "sub")
+	require.Error(t, err)
+
+	assert.Equal(t, []string{"outer.before", "inner.before", "inner.after", "outer.after",
+		"outer.before", "inner.before", "inner.after", "outer.after"}, order)
+	assert.Equal(t, "set-by-hook", header)
+
+	require.Len(t, requests, 2)
+	assert.Equal(t, "Namespaces.SetRetention", requests[0].Operation)
+	assert.Equal(t, http.MethodPost, requests[0].Method)
+	assert.Equal(t, "/admin/v2/namespaces/public/default/retention", requests[0].Endpoint)
+	assert.Equal(t, http.StatusNoContent, responses[0].StatusCode)
+	assert.NoError(t, responses[0].Err)
+	assert.Positive(t, responses[0].Latency)
+
+	assert.Equal(t, "Subscriptions.Delete", requests[1].Operation)
+	assert.Equal(t, http.StatusNotFound, responses[1].StatusCode)
+	assert.True(t, IsNotFound(responses[1].Err))
+}
+
+// TestOperationNames checks that every call made by the admin interfaces is
+// named after the method making it, such as "Topics.List".
+func TestOperationNames(t *testing.T) {
+	fset := token.NewFileSet()
+	files, err := filepath.Glob("api_*.go")
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+	for _, name := range files {
+		if strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, name, nil, 0)
+		require.NoError(t, err)
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil {
+				continue
+			}
+			var operation string
+			if fn.Recv != nil && fn.Name.IsExported() {
+				recv := fn.Recv.List[0].Type
+				if star, ok := recv.(*ast.StarExpr); ok {
+					recv = star.X
+				}
+				if group, ok := operationGroups[recv.(*ast.Ident).Name]; ok {
+					operation = group + "." + fn.Name.Name
+				}
+			}
+
+			named := make(map[ast.Node]bool)
+			ast.Inspect(fn.Body, func(node ast.Node) bool {
+				call, ok := node.(*ast.CallExpr)
+				if !ok {
+					return true
+				}
+				sel, ok := call.Fun.(*ast.SelectorExpr)
+				if !ok || sel.Sel.Name != "WithOperation" {
+					return true
+				}
+				named[sel.X] = true
+				lit, ok := call.Args[0].(*ast.BasicLit)
+				require.True(t, ok, "%s: operation name is not a literal", fset.Position(call.Pos()))
+				if operation != "" {
+					assert.Equal(t, strconv.Quote(operation), lit.Value, "%s", fset.Position(call.Pos()))
+				}
+				return true
+			})
+			ast.Inspect(fn.Body, func(node ast.Node) bool {
+				sel, ok := node.(*ast.SelectorExpr)
+				if ok && sel.Sel.Name == "restClient" {
+					assert.True(t, named[sel], "%s: call without an operation name", fset.Position(sel.Pos()))
+				}
+				return true
+			})
+		}
+	}
+}
+
+// TestOperationGroups checks that operationGroups names the type behind every
+// admin interface returned by the Client.
+func TestOperationGroups(t *testing.T) {
+	client, err := NewClient(ClientConfig{})
+	require.NoError(t, err)
+
+	used := map[string]bool{"pulsarClient": true}
+	clientType := reflect.TypeOf((*Client)(nil)).Elem()
+	for i := 0; i < clientType.NumMethod(); i++ {
+		group := clientType.Method(i)
+		if group.Type.NumIn() != 0 || group.Type.NumOut() != 1 || group.Type.Out(0).Kind() != reflect.Interface {
+			continue
+		}
+		if group.Type.Out(0) == clientType {
+			continue
+		}
+		recv := reflect.ValueOf(client).MethodByName(group.Name).Call(nil)[0].Elem().Type()
+		if recv.Kind() == reflect.Ptr {
+			recv = recv.Elem()
+		}
+		assert.Equal(t, group.Name, operationGroups[recv.Name()], "operation group of %s", recv.Name())
+		used[recv.Name()] = true
+	}
+	for recv := range operationGroups {
+		assert.True(t, used[recv], "unused operation group %s", recv)
+	}
+}
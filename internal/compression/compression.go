@@ -0,0 +1,96 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package compression decompresses the payloads of Pulsar messages, which
+// the broker returns as they were written by the producer.
+package compression
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+)
+
+// Type is a compression type, named as in the broker responses.
+type Type string
+
+const (
+	None   Type = "NONE"
+	LZ4    Type = "LZ4"
+	ZLIB   Type = "ZLIB"
+	ZSTD   Type = "ZSTD"
+	Snappy Type = "SNAPPY"
+)
+
+// zstdDecoder is shared by all the calls; DecodeAll is safe for concurrent
+// use.
+var zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderConcurrency(0))
+
+// DefaultMaxSize is the default maxMessageSize of the Pulsar brokers, which
+// bounds the size of a message that is not chunked.
+const DefaultMaxSize = 5 << 20
+
+// Decompress returns the uncompressed payload. The uncompressed size is
+// required by LZ4, whose blocks do not record it, and checked for the other
+// types when it is positive. As an LZ4 payload is decompressed into a buffer
+// of that size, a size above maxSize is rejected before anything is
+// allocated. An empty type means no compression.
+func Decompress(t Type, payload []byte, uncompressedSize, maxSize int) ([]byte, error) {
+	var (
+		out []byte
+		err error
+	)
+	switch Type(strings.ToUpper(string(t))) {
+	case None, "":
+		return payload, nil
+	case LZ4:
+		if uncompressedSize <= 0 {
+			return nil, fmt.Errorf("decompress LZ4: unknown uncompressed size")
+		}
+		if uncompressedSize > maxSize {
+			return nil, fmt.Errorf("decompress LZ4: uncompressed size %d exceeds the maximum of %d", uncompressedSize,
+				maxSize)
+		}
+		out = make([]byte, uncompressedSize)
+		var n int
+		n, err = lz4.UncompressBlock(payload, out)
+		out = out[:n]
+	case ZLIB:
+		var r io.ReadCloser
+		if r, err = zlib.NewReader(bytes.NewReader(payload)); err == nil {
+			out, err = io.ReadAll(r)
+			r.Close()
+		}
+	case ZSTD:
+		out, err = zstdDecoder.DecodeAll(payload, nil)
+	case Snappy:
+		out, err = snappy.Decode(nil, payload)
+	default:
+		return nil, fmt.Errorf("unsupported compression type %q", t)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("decompress %s: %w", t, err)
+	}
+	if uncompressedSize > 0 && len(out) != uncompressedSize {
+		return nil, fmt.Errorf("decompress %s: got %d bytes, expected %d", t, len(out), uncompressedSize)
+	}
+	return out, nil
+}
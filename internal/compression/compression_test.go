@@ -0,0 +1,89 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package compression
+
+import (
+	"bytes"
+	"compress/zlib"
+	"testing"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var payload = bytes.Repeat([]byte("pulsar admin payload "), 64)
+
+func compress(t *testing.T, typ Type, data []byte) []byte {
+	t.Helper()
+	switch typ {
+	case LZ4:
+		out := make([]byte, lz4.CompressBlockBound(len(data)))
+		n, err := lz4.CompressBlock(data, out, nil)
+		require.NoError(t, err)
+		return out[:n]
+	case ZLIB:
+		var buf bytes.Buffer
+		w := zlib.NewWriter(&buf)
+		_, err := w.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		return buf.Bytes()
+	case ZSTD:
+		enc, err := zstd.NewWriter(nil)
+		require.NoError(t, err)
+		defer enc.Close()
+		return enc.EncodeAll(data, nil)
+	case Snappy:
+		return snappy.Encode(nil, data)
+	}
+	return data
+}
+
+func TestDecompress(t *testing.T) {
+	for _, typ := range []Type{None, LZ4, ZLIB, ZSTD, Snappy} {
+		t.Run(string(typ), func(t *testing.T) {
+			got, err := Decompress(typ, compress(t, typ, payload), len(payload), DefaultMaxSize)
+			require.NoError(t, err)
+			assert.Equal(t, payload, got)
+		})
+	}
+}
+
+func TestDecompressIgnoresCase(t *testing.T) {
+	got, err := Decompress("lz4", compress(t, LZ4, payload), len(payload), DefaultMaxSize)
+	require.NoError(t, err)
+	assert.Equal(t, payload, got)
+}
+
+func TestDecompressErrors(t *testing.T) {
+	_, err := Decompress(LZ4, compress(t, LZ4, payload), 0, DefaultMaxSize)
+	assert.ErrorContains(t, err, "unknown uncompressed size")
+
+	_, err = Decompress(LZ4, compress(t, LZ4, payload), DefaultMaxSize+1, DefaultMaxSize)
+	assert.ErrorContains(t, err, "exceeds the maximum")
+
+	_, err = Decompress(ZSTD, compress(t, ZSTD, payload), len(payload)+1, DefaultMaxSize)
+	assert.ErrorContains(t, err, "expected")
+
+	_, err = Decompress(ZLIB, payload, len(payload), DefaultMaxSize)
+	assert.Error(t, err)
+
+	_, err = Decompress("BROTLI", payload, 0, DefaultMaxSize)
+	assert.ErrorContains(t, err, "unsupported compression type")
+}
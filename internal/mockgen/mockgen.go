@@ -0,0 +1,461 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package mockgen generates testify mocks for the interfaces of a package. It
+// backs the pulsaradminmock package.
+package mockgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const header = `// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Code generated by internal/mockgen. DO NOT EDIT.
+
+`
+
+const mockImport = "github.com/stretchr/testify/mock"
+
+// Config describes the package to mock and the package to generate.
+type Config struct {
+	// Dir is the directory of the package to mock.
+	Dir string
+	// ImportPath is the import path of the package to mock.
+	ImportPath string
+	// Package is the name of the generated package.
+	Package string
+	// Root is the interface to mock along with the interfaces returned by its
+	// methods. If empty, every exported interface of the package is mocked.
+	Root string
+}
+
+type iface struct {
+	name    string
+	file    *ast.File
+	methods []*ast.Field
+}
+
+type generator struct {
+	config  Config
+	pkgName string
+	types   map[string]bool
+	ifaces  map[string]*iface
+	imports map[string]string
+	buf     bytes.Buffer
+	err     error
+}
+
+// Generate returns the formatted source of a package that declares a mock,
+// named after the interface, for config.Root and the interfaces returned by
+// its methods, or for every exported interface of the package in config.Dir
+// if config.Root is empty.
+func Generate(config Config) ([]byte, error) {
+	g := &generator{
+		config:  config,
+		types:   make(map[string]bool),
+		ifaces:  make(map[string]*iface),
+		imports: map[string]string{mockImport: "mock"},
+	}
+	if err := g.parse(); err != nil {
+		return nil, err
+	}
+	g.imports[config.ImportPath] = g.pkgName
+
+	names, err := g.mocked()
+	if err != nil {
+		return nil, err
+	}
+
+	var body bytes.Buffer
+	for _, name := range names {
+		g.buf.Reset()
+		if err := g.mock(g.ifaces[name]); err != nil {
+			return nil, fmt.Errorf("mock %s: %w", name, err)
+		}
+		if g.err != nil {
+			return nil, fmt.Errorf("mock %s: %w", name, g.err)
+		}
+		body.Write(g.buf.Bytes())
+	}
+
+	var out bytes.Buffer
+	out.WriteString(header)
+	fmt.Fprintf(&out, "package %s\n\nimport (\n", config.Package)
+	// Standard library imports come first, in a group of their own.
+	var std, others []string
+	for path := range g.imports {
+		if strings.Contains(strings.SplitN(path, "/", 2)[0], ".") {
+			others = append(others, path)
+		} else {
+			std = append(std, path)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(others)
+	for i, group := range [][]string{std, others} {
+		if i > 0 && len(std) > 0 {
+			out.WriteString("\n")
+		}
+		for _, path := range group {
+			if name := g.imports[path]; name != path[strings.LastIndex(path, "/")+1:] {
+				fmt.Fprintf(&out, "\t%s %q\n", name, path)
+			} else {
+				fmt.Fprintf(&out, "\t%q\n", path)
+			}
+		}
+	}
+	out.WriteString(")\n\n")
+	out.WriteString("// TestingT is the subset of testing.TB used by the mock constructors.\n")
+	out.WriteString("type TestingT interface {\n\tmock.TestingT\n\tCleanup(func())\n}\n")
+	out.Write(body.Bytes())
+
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("format generated source: %w", err)
+	}
+	return src, nil
+}
+
+// parse collects the exported types and interfaces of the package.
+func (g *generator) parse() error {
+	entries, err := os.ReadDir(g.config.Dir)
+	if err != nil {
+		return err
+	}
+	fset := token.NewFileSet()
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, filepath.Join(g.config.Dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return err
+		}
+		g.pkgName = file.Name.Name
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				ts := spec.(*ast.TypeSpec)
+				if !ts.Name.IsExported() {
+					continue
+				}
+				g.types[ts.Name.Name] = true
+				if it, ok := ts.Type.(*ast.InterfaceType); ok {
+					g.ifaces[ts.Name.Name] = &iface{name: ts.Name.Name, file: file, methods: it.Methods.List}
+				}
+			}
+		}
+	}
+	if g.pkgName == "" {
+		return fmt.Errorf("no Go files in %s", g.config.Dir)
+	}
+	return nil
+}
+
+// mocked returns the sorted names of the interfaces to mock.
+func (g *generator) mocked() ([]string, error) {
+	var names []string
+	if g.config.Root == "" {
+		for name := range g.ifaces {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return names, nil
+	}
+
+	root := g.ifaces[g.config.Root]
+	if root == nil {
+		return nil, fmt.Errorf("no interface %s in %s", g.config.Root, g.config.Dir)
+	}
+	methods, err := g.methodSet(root)
+	if err != nil {
+		return nil, fmt.Errorf("mock %s: %w", root.name, err)
+	}
+	seen := map[string]bool{root.name: true}
+	names = append(names, root.name)
+	for _, m := range methods {
+		if m.typ.Results == nil {
+			continue
+		}
+		for _, result := range m.typ.Results.List {
+			ident, ok := result.Type.(*ast.Ident)
+			if ok && g.ifaces[ident.Name] != nil && !seen[ident.Name] {
+				seen[ident.Name] = true
+				names = append(names, ident.Name)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// method is a method of an interface with the file it is declared in, which
+// resolves the package names used by its signature.
+type method struct {
+	name string
+	typ  *ast.FuncType
+	file *ast.File
+}
+
+// methodSet returns the methods of an interface, including the methods of the
+// interfaces it embeds.
+func (g *generator) methodSet(it *iface) ([]method, error) {
+	var methods []method
+	for _, field := range it.methods {
+		if len(field.Names) > 0 {
+			methods = append(methods, method{field.Names[0].Name, field.Type.(*ast.FuncType), it.file})
+			continue
+		}
+		ident, ok := field.Type.(*ast.Ident)
+		switch {
+		case ok && ident.Name == "error":
+			errorType := &ast.FuncType{
+				Params:  &ast.FieldList{},
+				Results: &ast.FieldList{List: []*ast.Field{{Type: ast.NewIdent("string")}}},
+			}
+			methods = append(methods, method{"Error", errorType, it.file})
+		case ok && g.ifaces[ident.Name] != nil:
+			embedded, err := g.methodSet(g.ifaces[ident.Name])
+			if err != nil {
+				return nil, err
+			}
+			methods = append(methods, embedded...)
+		default:
+			return nil, fmt.Errorf("unsupported embedded type %s", g.typeString(field.Type, it.file))
+		}
+	}
+	return methods, nil
+}
+
+func (g *generator) mock(it *iface) error {
+	methods, err := g.methodSet(it)
+	if err != nil {
+		return err
+	}
+	qualified := g.pkgName + "." + it.name
+
+	g.printf("\n// %s is a mock of %s.\ntype %s struct {\n\tmock.Mock\n}\n\n", it.name, qualified, it.name)
+	g.printf("var _ %s = (*%s)(nil)\n\n", qualified, it.name)
+	g.printf("// New%s returns a new %s mock that asserts its expectations\n", it.name, it.name)
+	g.printf("// when the test completes.\n")
+	g.printf("func New%s(t TestingT) *%s {\n\tm := &%s{}\n\tm.Mock.Test(t)\n", it.name, it.name, it.name)
+	g.printf("\tt.Cleanup(func() { m.AssertExpectations(t) })\n\treturn m\n}\n")
+
+	for _, m := range methods {
+		g.method(it.name, qualified, m)
+	}
+	return nil
+}
+
+func (g *generator) method(mockName, qualified string, m method) {
+	var params, args, types []string
+	variadic := false
+	for i, p := range expand(m.typ.Params) {
+		name := p.name
+		if reserved(name) {
+			name = "a" + strconv.Itoa(i)
+		}
+		typ := g.typeString(p.typ, m.file)
+		if ellipsis, ok := p.typ.(*ast.Ellipsis); ok {
+			variadic = true
+			typ = "..." + g.typeString(ellipsis.Elt, m.file)
+		}
+		params = append(params, name+" "+typ)
+		args = append(args, name)
+		types = append(types, typ)
+	}
+	var results []string
+	for _, r := range expand(m.typ.Results) {
+		results = append(results, g.typeString(r.typ, m.file))
+	}
+
+	g.printf("\n// %s mocks %s.%s.\n", m.name, qualified, m.name)
+	g.printf("func (m *%s) %s(%s)", mockName, m.name, strings.Join(params, ", "))
+	switch len(results) {
+	case 0:
+	case 1:
+		g.printf(" %s", results[0])
+	default:
+		g.printf(" (%s)", strings.Join(results, ", "))
+	}
+	g.printf(" {\n")
+	if len(results) == 0 {
+		g.printf("\tm.Called(%s)\n}\n", strings.Join(args, ", "))
+		return
+	}
+
+	call := strings.Join(args, ", ")
+	if variadic {
+		call += "..."
+	}
+	signature := "func(" + strings.Join(types, ", ") + ") " + results[0]
+	if len(results) > 1 {
+		signature = "func(" + strings.Join(types, ", ") + ") (" + strings.Join(results, ", ") + ")"
+	}
+	g.printf("\targs := m.Called(%s)\n", strings.Join(args, ", "))
+	g.printf("\tif fn, ok := args.Get(0).(%s); ok {\n\t\treturn fn(%s)\n\t}\n", signature, call)
+	rets := make([]string, len(results))
+	for i, typ := range results {
+		rets[i] = "r" + strconv.Itoa(i)
+		g.printf("\tvar %s %s\n", rets[i], typ)
+		g.printf("\tif v := args.Get(%d); v != nil {\n\t\t%s = v.(%s)\n\t}\n", i, rets[i], typ)
+	}
+	g.printf("\treturn %s\n}\n", strings.Join(rets, ", "))
+}
+
+type param struct {
+	name string
+	typ  ast.Expr
+}
+
+// expand returns one param per name of a field list.
+func expand(fields *ast.FieldList) []param {
+	if fields == nil {
+		return nil
+	}
+	var params []param
+	for _, f := range fields.List {
+		if len(f.Names) == 0 {
+			params = append(params, param{typ: f.Type})
+			continue
+		}
+		for _, name := range f.Names {
+			params = append(params, param{name.Name, f.Type})
+		}
+	}
+	return params
+}
+
+// reserved reports whether a parameter name cannot be used as is, because it
+// is blank or clashes with the names used by the generated methods.
+func reserved(name string) bool {
+	switch name {
+	case "", "_", "m", "args", "fn", "v":
+		return true
+	}
+	if strings.HasPrefix(name, "r") {
+		_, err := strconv.Atoi(name[1:])
+		return err == nil
+	}
+	return false
+}
+
+// typeString prints a type expression of file, qualifying the types of the
+// mocked package and recording the imports it needs.
+func (g *generator) typeString(e ast.Expr, file *ast.File) string {
+	switch t := e.(type) {
+	case *ast.Ident:
+		if g.types[t.Name] {
+			return g.pkgName + "." + t.Name
+		}
+		return t.Name
+	case *ast.SelectorExpr:
+		pkg := t.X.(*ast.Ident).Name
+		if path := importPath(file, pkg); path != "" {
+			g.imports[path] = pkg
+		}
+		return pkg + "." + t.Sel.Name
+	case *ast.StarExpr:
+		return "*" + g.typeString(t.X, file)
+	case *ast.ArrayType:
+		if t.Len == nil {
+			return "[]" + g.typeString(t.Elt, file)
+		}
+		return "[" + t.Len.(*ast.BasicLit).Value + "]" + g.typeString(t.Elt, file)
+	case *ast.MapType:
+		return "map[" + g.typeString(t.Key, file) + "]" + g.typeString(t.Value, file)
+	case *ast.Ellipsis:
+		return "[]" + g.typeString(t.Elt, file)
+	case *ast.ChanType:
+		switch t.Dir {
+		case ast.SEND:
+			return "chan<- " + g.typeString(t.Value, file)
+		case ast.RECV:
+			return "<-chan " + g.typeString(t.Value, file)
+		}
+		return "chan " + g.typeString(t.Value, file)
+	case *ast.InterfaceType:
+		if t.Methods == nil || len(t.Methods.List) == 0 {
+			return "interface{}"
+		}
+	case *ast.FuncType:
+		var params, results []string
+		for _, p := range expand(t.Params) {
+			params = append(params, g.typeString(p.typ, file))
+		}
+		for _, r := range expand(t.Results) {
+			results = append(results, g.typeString(r.typ, file))
+		}
+		s := "func(" + strings.Join(params, ", ") + ")"
+		switch len(results) {
+		case 0:
+			return s
+		case 1:
+			return s + " " + results[0]
+		}
+		return s + " (" + strings.Join(results, ", ") + ")"
+	}
+	if g.err == nil {
+		g.err = fmt.Errorf("unsupported type %T", e)
+	}
+	return ""
+}
+
+// importPath returns the path of the import of file named pkg.
+func importPath(file *ast.File, pkg string) string {
+	for _, spec := range file.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		name := path[strings.LastIndex(path, "/")+1:]
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		if name == pkg {
+			return path
+		}
+	}
+	return ""
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
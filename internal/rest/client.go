@@ -17,6 +17,7 @@ package rest
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -31,6 +32,7 @@ func NewClient(transport http.RoundTripper, serviceURL, userAgent string) *Clien
 			Transport: transport,
 			// TODO: Sane default timeouts?
 			// Timeout: 0,
+			CheckRedirect: stopRedirects,
 		},
 		UserAgent: userAgent,
 	}
@@ -41,6 +43,65 @@ type Client struct {
 	ServiceURL string
 	HTTPClient *http.Client
 	UserAgent  string
+	// RetryPolicy is consulted after every failed attempt. Requests are only
+	// attempted once when it is nil.
+	RetryPolicy RetryPolicy
+	// MaxRedirects is the number of redirects followed per attempt. Zero
+	// means DefaultMaxRedirects and a negative value disables redirects.
+	MaxRedirects int
+	// Interceptor, if set, is invoked around every call.
+	Interceptor Interceptor
+	// Limiter, if set, throttles every call.
+	Limiter Limiter
+	// ServiceURLs, if set, are the service URLs requests fail over to when
+	// the connection to the current one fails. They take precedence over
+	// ServiceURL, which must be the first of them.
+	ServiceURLs *ServiceURLs
+
+	// operation is the name of the admin operation the calls are made for.
+	operation string
+	ctx       context.Context
+}
+
+// WithOperation returns a shallow copy of the client whose calls are made for
+// the named admin operation, such as "Topics.List", passed to the Interceptor
+// and the Limiter with every call.
+func (c *Client) WithOperation(operation string) *Client {
+	c2 := new(Client)
+	*c2 = *c
+	c2.operation = operation
+	return c2
+}
+
+// WithServiceURL returns a shallow copy of the client whose requests are all
+// sent to serviceURL, without failing over to other service URLs.
+func (c *Client) WithServiceURL(serviceURL string) *Client {
+	c2 := new(Client)
+	*c2 = *c
+	c2.ServiceURL = serviceURL
+	c2.ServiceURLs = nil
+	return c2
+}
+
+// WithContext returns a shallow copy of the client whose requests are all
+// bound to ctx. The copy shares the underlying HTTP client with c.
+func (c *Client) WithContext(ctx context.Context) *Client {
+	if ctx == nil {
+		panic("nil context")
+	}
+	c2 := new(Client)
+	*c2 = *c
+	c2.ctx = ctx
+	return c2
+}
+
+// Context returns the context requests made by the client are bound to. The
+// returned context is always non-nil; it defaults to the background context.
+func (c *Client) Context() context.Context {
+	if c.ctx != nil {
+		return c.ctx
+	}
+	return context.Background()
 }
 
 func (c *Client) newRequest(method, path string) (*request, error) {
@@ -62,16 +123,21 @@ func (c *Client) newRequest(method, path string) (*request, error) {
 			Path:   endpoint(base.Path, u.Path),
 		},
 		params: make(url.Values),
+		path:   u.Path,
 	}
 	return req, nil
 }
 
-func (c *Client) doRequest(r *request) (*http.Response, error) {
-	req, err := r.toHTTP()
+func (c *Client) doRequest(ctx context.Context, r *request) (*http.Response, error) {
+	req, err := r.toHTTP(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	for k, v := range r.header {
+		req.Header[k] = v
+	}
+
 	if r.contentType != "" {
 		req.Header.Set("Content-Type", r.contentType)
 	} else if req.Body != nil {
@@ -80,7 +146,20 @@ func (c *Client) doRequest(r *request) (*http.Response, error) {
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", c.UserAgent)
 
-	return c.HTTPClient.Do(req)
+	for attempt := 1; ; attempt++ {
+		resp, err := c.sendWithFailover(req, r)
+		delay, retry := c.shouldRetry(attempt, req, resp, err)
+		if !retry {
+			return resp, err
+		}
+		discardResp(resp)
+		if err := wait(req, delay); err != nil {
+			return nil, err
+		}
+		if req, err = rewindRequest(req); err != nil {
+			return nil, err
+		}
+	}
 }
 
 // MakeRequest can make a simple request and handle the response by yourself
@@ -90,7 +169,7 @@ func (c *Client) MakeRequest(method, endpoint string) (*http.Response, error) {
 		return nil, err
 	}
 
-	resp, err := checkSuccessful(c.doRequest(req))
+	resp, err := c.call(req)
 	if err != nil {
 		return nil, err
 	}
@@ -126,7 +205,7 @@ func (c *Client) GetWithOptions(endpoint string, obj interface{}, params map[str
 	}
 
 	//nolint:bodyclose
-	resp, err := checkSuccessful(c.doRequest(req))
+	resp, err := c.call(req)
 	if err != nil {
 		return nil, err
 	}
@@ -177,7 +256,7 @@ func (c *Client) PutWithQueryParams(endpoint string, in, obj interface{}, params
 	}
 
 	//nolint:bodyclose
-	resp, err := checkSuccessful(c.doRequest(req))
+	resp, err := c.call(req)
 	if err != nil {
 		return err
 	}
@@ -201,7 +280,7 @@ func (c *Client) PutWithMultiPart(endpoint string, body io.Reader, contentType s
 	req.contentType = contentType
 
 	//nolint
-	resp, err := checkSuccessful(c.doRequest(req))
+	resp, err := c.call(req)
 	if err != nil {
 		return err
 	}
@@ -229,7 +308,7 @@ func (c *Client) DeleteWithQueryParams(endpoint string, params map[string]string
 	}
 
 	//nolint
-	resp, err := checkSuccessful(c.doRequest(req))
+	resp, err := c.call(req)
 	if err != nil {
 		return err
 	}
@@ -250,7 +329,7 @@ func (c *Client) PostWithObj(endpoint string, in, obj interface{}) error {
 	req.obj = in
 
 	//nolint
-	resp, err := checkSuccessful(c.doRequest(req))
+	resp, err := c.call(req)
 	if err != nil {
 		return err
 	}
@@ -274,7 +353,44 @@ func (c *Client) PostWithMultiPart(endpoint string, in interface{}, body io.Read
 	req.contentType = contentType
 
 	//nolint
-	resp, err := checkSuccessful(c.doRequest(req))
+	resp, err := c.call(req)
+	if err != nil {
+		return err
+	}
+	defer safeRespClose(resp)
+
+	return nil
+}
+
+// PostWithMultiPartStream posts a body opened by open, which is streamed as
+// it is read. A replayable body is opened again to follow a redirect or to
+// retry the request.
+func (c *Client) PostWithMultiPartStream(endpoint string, open func() (io.ReadCloser, error), replayable bool,
+	contentType string,
+) error {
+	return c.sendStream(http.MethodPost, endpoint, open, replayable, contentType)
+}
+
+// PutWithMultiPartStream puts a body opened by open, like
+// PostWithMultiPartStream.
+func (c *Client) PutWithMultiPartStream(endpoint string, open func() (io.ReadCloser, error), replayable bool,
+	contentType string,
+) error {
+	return c.sendStream(http.MethodPut, endpoint, open, replayable, contentType)
+}
+
+func (c *Client) sendStream(method, endpoint string, open func() (io.ReadCloser, error), replayable bool,
+	contentType string,
+) error {
+	req, err := c.newRequest(method, endpoint)
+	if err != nil {
+		return err
+	}
+	req.openBody = open
+	req.replayable = replayable
+	req.contentType = contentType
+
+	resp, err := c.call(req)
 	if err != nil {
 		return err
 	}
@@ -299,7 +415,7 @@ func (c *Client) PostWithQueryParams(endpoint string, in interface{}, params map
 		req.params = query
 	}
 	//nolint
-	resp, err := checkSuccessful(c.doRequest(req))
+	resp, err := c.call(req)
 	if err != nil {
 		return err
 	}
@@ -313,12 +429,20 @@ type request struct {
 	contentType string
 	url         *url.URL
 	params      url.Values
+	header      http.Header
+	// path is the path of the request relative to the service URL
+	path string
 
 	obj  interface{}
 	body io.Reader
+	// openBody, when set, opens the body each time the request is sent. The
+	// request is sent again on a redirect, a retry or a failover only when
+	// the body is replayable.
+	openBody   func() (io.ReadCloser, error)
+	replayable bool
 }
 
-func (r *request) toHTTP() (*http.Request, error) {
+func (r *request) toHTTP(ctx context.Context) (*http.Request, error) {
 	r.url.RawQuery = r.params.Encode()
 
 	// add a request body if there is one
@@ -330,10 +454,25 @@ func (r *request) toHTTP() (*http.Request, error) {
 		r.body = body
 	}
 
-	req, err := http.NewRequest(r.method, r.url.RequestURI(), r.body)
+	body := r.body
+	if r.openBody != nil {
+		opened, err := r.openBody()
+		if err != nil {
+			return nil, err
+		}
+		body = opened
+	}
+
+	req, err := http.NewRequestWithContext(ctx, r.method, r.url.RequestURI(), body)
 	if err != nil {
+		if closer, ok := body.(io.Closer); ok && r.openBody != nil {
+			closer.Close()
+		}
 		return nil, err
 	}
+	if r.openBody != nil && r.replayable {
+		req.GetBody = r.openBody
+	}
 
 	req.URL.Host = r.url.Host
 	req.URL.Scheme = r.url.Scheme
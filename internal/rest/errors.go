@@ -2,11 +2,39 @@ package rest
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"net/http"
 )
 
+// Error is returned for every unsuccessful response. It wraps either an
+// *apiError or a *serverError, whose message it reuses.
+type Error struct {
+	// Method is the HTTP method of the request.
+	Method string
+	// Endpoint is the path of the request URL.
+	Endpoint string
+	// StatusCode is the HTTP status code returned by the server.
+	StatusCode int
+	// Reason is the reason returned by the server for errors described in the
+	// admin API contract. It is empty for unexpected errors.
+	Reason string
+	// Response is the raw response body returned by the server.
+	Response string
+
+	err error
+}
+
+func (e *Error) Error() string {
+	return e.err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.err
+}
+
 type apiError struct {
 	reason string
 	code   int
@@ -50,20 +78,38 @@ func (e *serverError) Code() int {
 // IsAdminErr will return true if the server returns an administrative error with
 // a `reason`
 func IsAdminErr(err error) bool {
-	_, ok := err.(*apiError)
-	return ok
+	var apiErr *apiError
+	return errors.As(err, &apiErr)
 }
 
-// responseError will convert an HTTP response to either an *AdminError or a
-// *RequestError, depending on whether ar `reason` was found.
+// IsDialError reports whether err happened while connecting, in which case the
+// request never reached the server.
+func IsDialError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// responseError will convert an HTTP response to an *Error wrapping either an
+// *apiError or a *serverError, depending on whether a `reason` was found.
 func responseError(resp *http.Response) error {
+	e := &Error{
+		StatusCode: resp.StatusCode,
+	}
+	if resp.Request != nil {
+		e.Method = resp.Request.Method
+		e.Endpoint = resp.Request.URL.Path
+	}
+
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return &serverError{
+		e.err = &serverError{
 			response: fmt.Sprintf("%d-%s - response body could not be read: %v", resp.StatusCode, resp.Status, err),
 			code:     resp.StatusCode,
 		}
+		return e
 	}
+	e.Response = string(body)
+
 	jsonResp := struct {
 		Reason string `json:"reason"`
 	}{}
@@ -74,14 +120,17 @@ func responseError(resp *http.Response) error {
 		_ = json.Unmarshal(body, &jsonResp)
 	}
 	if jsonResp.Reason != "" {
-		return &apiError{
+		e.Reason = jsonResp.Reason
+		e.err = &apiError{
 			reason: jsonResp.Reason,
 			code:   resp.StatusCode,
 		}
+		return e
 	}
 
-	return &serverError{
+	e.err = &serverError{
 		code:     resp.StatusCode,
 		response: string(body),
 	}
+	return e
 }
@@ -0,0 +1,199 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// probeTimeout bounds the health probe of an unreachable service URL.
+const probeTimeout = 10 * time.Second
+
+// ServiceURLs is a set of equivalent service URLs, in order of preference.
+// Requests are sent to the first healthy one. A URL that cannot be connected
+// to is marked unhealthy and only used as a last resort until it passes a
+// health probe. Only connection errors fail over: a request that reached a
+// server is never sent to another one, whatever its response, since it may
+// have taken effect, so that a server answering 503 is not failed over and
+// its errors are left to the retry policy. Unhealthy URLs are re-probed on demand: a request starts the
+// probes of those last probed at least Interval ago, and nothing probes them
+// while no request is made.
+type ServiceURLs struct {
+	// Probe checks whether the given service URL is healthy.
+	Probe func(ctx context.Context, serviceURL string) error
+	// Interval is the minimum delay between two probes of a URL.
+	Interval time.Duration
+
+	mu   sync.Mutex
+	urls []*serviceURL
+	now  func() time.Time
+}
+
+type serviceURL struct {
+	url       *url.URL
+	healthy   bool
+	probing   bool
+	lastProbe time.Time
+}
+
+// NewServiceURLs parses serviceURLs, which are all initially healthy.
+func NewServiceURLs(serviceURLs []string, interval time.Duration) (*ServiceURLs, error) {
+	if len(serviceURLs) == 0 {
+		return nil, errors.New("no service URL")
+	}
+	s := &ServiceURLs{
+		Interval: interval,
+		now:      time.Now,
+	}
+	for _, raw := range serviceURLs {
+		u, err := url.Parse(raw)
+		if err != nil {
+			return nil, err
+		}
+		s.urls = append(s.urls, &serviceURL{url: u, healthy: true})
+	}
+	return s, nil
+}
+
+// candidates returns the healthy URLs followed by the unhealthy ones, and
+// starts probing the unhealthy URLs that are due.
+func (s *ServiceURLs) candidates() []*url.URL {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	healthy := make([]*url.URL, 0, len(s.urls))
+	var unhealthy []*url.URL
+	for _, u := range s.urls {
+		if u.healthy {
+			healthy = append(healthy, u.url)
+			continue
+		}
+		unhealthy = append(unhealthy, u.url)
+		if s.Probe != nil && !u.probing && s.now().Sub(u.lastProbe) >= s.Interval {
+			u.probing = true
+			u.lastProbe = s.now()
+			go s.probe(u)
+		}
+	}
+	return append(healthy, unhealthy...)
+}
+
+func (s *ServiceURLs) probe(u *serviceURL) {
+	ctx, cancel := context.WithTimeout(context.Background(), probeTimeout)
+	defer cancel()
+	err := s.Probe(ctx, u.url.String())
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	u.probing = false
+	if err == nil {
+		u.healthy = true
+	}
+}
+
+func (s *ServiceURLs) setHealthy(target *url.URL, healthy bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, u := range s.urls {
+		if u.url == target {
+			if !healthy && u.healthy {
+				u.lastProbe = s.now()
+			}
+			u.healthy = healthy
+		}
+	}
+}
+
+// Healthy returns the service URLs currently considered healthy.
+func (s *ServiceURLs) Healthy() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var healthy []string
+	for _, u := range s.urls {
+		if u.healthy {
+			healthy = append(healthy, u.url.String())
+		}
+	}
+	return healthy
+}
+
+// sendWithFailover sends req to the service URLs of the client in turn until
+// one of them can be connected to.
+func (c *Client) sendWithFailover(req *http.Request, r *request) (*http.Response, error) {
+	if c.ServiceURLs == nil {
+		return c.send(req)
+	}
+
+	var lastErr error
+	for i, base := range c.ServiceURLs.candidates() {
+		if i > 0 {
+			if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+				return nil, lastErr
+			}
+			next, err := rewindRequest(req)
+			if err != nil {
+				return nil, err
+			}
+			req = next
+		}
+		req.URL.Scheme = base.Scheme
+		req.URL.User = base.User
+		req.URL.Host = base.Host
+		req.URL.Path = endpoint(base.Path, r.path)
+		req.Host = base.Host
+
+		resp, err := c.send(req)
+		if err == nil || req.Context().Err() != nil || !IsDialError(err) {
+			if err == nil {
+				c.ServiceURLs.setHealthy(base, true)
+			}
+			return resp, err
+		}
+		c.ServiceURLs.setHealthy(base, false)
+		lastErr = err
+	}
+	return nil, lastErr
+}
+
+// Probe sends a GET request for endpoint to serviceURL and returns the body of
+// its successful response. Unlike the calls of the client, the request is
+// neither retried, redirected nor failed over, and it bypasses the
+// Interceptor and the Limiter.
+func (c *Client) Probe(ctx context.Context, serviceURL, endpoint string) ([]byte, error) {
+	r, err := c.WithServiceURL(serviceURL).newRequest(http.MethodGet, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	req, err := r.toHTTP(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", c.UserAgent)
+
+	//nolint:bodyclose
+	resp, err := checkSuccessful(c.HTTPClient.Do(req))
+	if err != nil {
+		return nil, err
+	}
+	defer safeRespClose(resp)
+	return io.ReadAll(resp.Body)
+}
@@ -0,0 +1,111 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package rest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// Call describes a single logical call made through the Client. A call may
+// span several attempts and redirects.
+type Call struct {
+	// Operation is the name of the admin operation that issued the call.
+	Operation string
+	Method    string
+	Endpoint  string
+	// Header holds extra headers sent with every attempt of the call.
+	Header http.Header
+}
+
+// Interceptor is invoked before every call. It returns the context the call
+// proceeds with, and a function invoked with the outcome once it completes.
+type Interceptor func(ctx context.Context, call *Call) (context.Context, func(statusCode int, err error))
+
+// Limiter throttles calls. Acquire blocks until the call may proceed or ctx is
+// done, and returns a function releasing the resources held by the call.
+type Limiter interface {
+	Acquire(ctx context.Context, call *Call) (func(), error)
+}
+
+// call sends r and checks that the response is successful, notifying the
+// client interceptor around it and waiting for the client limiter.
+func (c *Client) call(r *request) (*http.Response, error) {
+	ctx := c.Context()
+	if c.Interceptor == nil && c.Limiter == nil {
+		return checkSuccessful(c.doRequest(ctx, r))
+	}
+
+	call := &Call{
+		Operation: c.operation,
+		Method:    r.method,
+		Endpoint:  r.url.Path,
+		Header:    make(http.Header),
+	}
+	var done func(int, error)
+	if c.Interceptor != nil {
+		ctx, done = c.Interceptor(ctx, call)
+	}
+	r.header = call.Header
+
+	resp, err := c.limitedRequest(ctx, call, r)
+	statusCode := 0
+	if resp != nil {
+		statusCode = resp.StatusCode
+	}
+	//nolint:bodyclose
+	resp, err = checkSuccessful(resp, err)
+	if done != nil {
+		done(statusCode, err)
+	}
+	return resp, err
+}
+
+// limitedRequest sends r once the client limiter lets it through. The
+// resources held by the call are released once the response body is closed,
+// or right away if there is no response.
+func (c *Client) limitedRequest(ctx context.Context, call *Call, r *request) (*http.Response, error) {
+	if c.Limiter == nil {
+		return c.doRequest(ctx, r)
+	}
+	release, err := c.Limiter.Acquire(ctx, call)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.doRequest(ctx, r)
+	if err != nil || resp.Body == nil {
+		release()
+		return resp, err
+	}
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+// releasingBody is a response body releasing the resources held by its call
+// when it is first closed.
+type releasingBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
@@ -0,0 +1,100 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// DefaultMaxRedirects is the number of redirects followed per attempt when
+// Client.MaxRedirects is zero.
+const DefaultMaxRedirects = 10
+
+// RedirectHook is called for every redirect followed by the client.
+type RedirectHook func(method string, statusCode int, from, to *url.URL)
+
+type redirectHookKey struct{}
+
+// WithRedirectHook returns a copy of ctx carrying a hook that is called for
+// every redirect followed by requests bound to it.
+func WithRedirectHook(ctx context.Context, hook RedirectHook) context.Context {
+	return context.WithValue(ctx, redirectHookKey{}, hook)
+}
+
+// stopRedirects prevents the HTTP client from following redirects on its own:
+// it drops the body of some methods and any header set by the transport.
+func stopRedirects(_ *http.Request, _ []*http.Request) error {
+	return http.ErrUseLastResponse
+}
+
+// send sends req and follows the redirects brokers answer with when they do
+// not own the resource, replaying the request method, headers and body.
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	maxRedirects := c.MaxRedirects
+	if maxRedirects == 0 {
+		maxRedirects = DefaultMaxRedirects
+	}
+
+	for hops := 0; ; hops++ {
+		resp, err := c.HTTPClient.Do(req)
+		if err != nil || !followRedirect(req, resp) || maxRedirects < 0 {
+			return resp, err
+		}
+		discardResp(resp)
+
+		if hops >= maxRedirects {
+			return nil, fmt.Errorf("%s %s: stopped after %d redirects", req.Method, req.URL, maxRedirects)
+		}
+		location, err := resp.Location()
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: invalid redirect: %w", req.Method, req.URL, err)
+		}
+		if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+			return nil, fmt.Errorf("%s %s: cannot follow redirect to %s: %w", req.Method, req.URL, location,
+				errBodyNotReplayable)
+		}
+
+		next, err := rewindRequest(req)
+		if err != nil {
+			return nil, err
+		}
+		next.URL = location
+		next.Host = location.Host
+
+		if hook, ok := req.Context().Value(redirectHookKey{}).(RedirectHook); ok {
+			hook(req.Method, resp.StatusCode, req.URL, location)
+		}
+		req = next
+	}
+}
+
+var errBodyNotReplayable = errors.New("request body cannot be replayed")
+
+// followRedirect reports whether resp is a redirect that can be followed
+// without changing the request method.
+func followRedirect(req *http.Request, resp *http.Response) bool {
+	switch resp.StatusCode {
+	case http.StatusTemporaryRedirect, http.StatusPermanentRedirect:
+		return true
+	case http.StatusMovedPermanently, http.StatusFound, http.StatusSeeOther:
+		return req.Method == http.MethodGet || req.Method == http.MethodHead
+	}
+	return false
+}
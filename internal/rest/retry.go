@@ -0,0 +1,79 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package rest
+
+import (
+	"io"
+	"net/http"
+	"time"
+)
+
+// RetryPolicy decides whether a failed attempt is retried and how long to wait
+// before the next one.
+type RetryPolicy interface {
+	Retry(attempt int, req *http.Request, resp *http.Response, err error) (time.Duration, bool)
+}
+
+// shouldRetry consults the client's retry policy after an attempt. It never
+// retries once the request context is done, or when the request body cannot
+// be replayed.
+func (c *Client) shouldRetry(attempt int, req *http.Request, resp *http.Response, err error) (time.Duration, bool) {
+	if c.RetryPolicy == nil || req.Context().Err() != nil {
+		return 0, false
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return 0, false
+	}
+	if err == nil && respIsOk(resp) {
+		return 0, false
+	}
+	return c.RetryPolicy.Retry(attempt, req, resp, err)
+}
+
+// rewindRequest returns a copy of req with a fresh body so that it can be sent
+// again.
+func rewindRequest(req *http.Request) (*http.Request, error) {
+	next := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		next.Body = body
+	}
+	return next, nil
+}
+
+// wait blocks for the given delay or until the request context is done.
+func wait(req *http.Request, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-req.Context().Done():
+		return req.Context().Err()
+	}
+}
+
+// discardResp drains and closes a response that will not be handed back to
+// the caller, so that the underlying connection can be reused.
+func discardResp(resp *http.Response) {
+	if resp != nil {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		safeRespClose(resp)
+	}
+}
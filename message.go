@@ -17,6 +17,8 @@ package pulsaradmin
 
 //nolint
 import (
+	"time"
+
 	"github.com/golang/protobuf/proto"
 )
 
@@ -25,6 +27,40 @@ type Message struct {
 	Payload    []byte
 	Topic      string
 	Properties map[string]string
+
+	// Key is the partition key of the message, base64 encoded when
+	// KeyBase64Encoded is set.
+	Key              string
+	KeyBase64Encoded bool
+	// OrderingKey overrides the key for the ordering of Key_Shared
+	// subscriptions.
+	OrderingKey []byte
+	PublishTime time.Time
+	// EventTime is zero when the producer did not set it.
+	EventTime    time.Time
+	SequenceID   int64
+	ProducerName string
+	// RedeliveryCount is zero when the broker does not report it.
+	RedeliveryCount uint32
+	// ReplicatedFrom is the cluster the message was replicated from, empty
+	// for a local message.
+	ReplicatedFrom string
+	SchemaVersion  []byte
+
+	// UUID identifies the chunked message a chunk belongs to. ChunkID is the
+	// index of the chunk among the NumChunks chunks of the message, and
+	// TotalChunkMsgSize the size of the whole message.
+	UUID              string
+	ChunkID           int
+	NumChunks         int
+	TotalChunkMsgSize int
+	// ChunkMessageIDs are the IDs of the chunks of a reassembled message.
+	ChunkMessageIDs []MessageID
+
+	// compression and uncompressedSize are kept until the chunks of a
+	// compressed message are reassembled.
+	compression      string
+	uncompressedSize int
 }
 
 func NewMessage(topic string, id MessageID, payload []byte, properties map[string]string) *Message {
@@ -36,6 +72,11 @@ func NewMessage(topic string, id MessageID, payload []byte, properties map[strin
 	}
 }
 
+// isChunk reports whether the message is a chunk of a chunked message.
+func (m *Message) isChunk() bool {
+	return m.NumChunks > 1
+}
+
 func (m *Message) GetMessageID() MessageID {
 	return m.MessageID
 }
@@ -59,7 +100,9 @@ type SingleMessageMetadata struct {
 	EventTime              *uint64 `protobuf:"varint,5,opt,name=event_time,json=eventTime,def=0" json:"event_time,omitempty"`
 	PartitionKeyB64Encoded *bool   `protobuf:"varint,6,opt,name=partition_key_b64_encoded,json=partitionKeyB64Encoded,def=0" json:"partition_key_b64_encoded,omitempty"`
 	// Specific a key to overwrite the message key which used for ordering dispatch in Key_Shared mode.
-	OrderingKey          []byte   `protobuf:"bytes,7,opt,name=ordering_key,json=orderingKey" json:"ordering_key,omitempty"`
+	OrderingKey []byte `protobuf:"bytes,7,opt,name=ordering_key,json=orderingKey" json:"ordering_key,omitempty"`
+	// Allows consumer retrieve the sequence id that the producer set.
+	SequenceId           *uint64  `protobuf:"varint,8,opt,name=sequence_id,json=sequenceId" json:"sequence_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -75,6 +118,34 @@ func (m *SingleMessageMetadata) GetPayloadSize() int32 {
 	return 0
 }
 
+func (m *SingleMessageMetadata) GetPartitionKey() string {
+	if m != nil && m.PartitionKey != nil {
+		return *m.PartitionKey
+	}
+	return ""
+}
+
+func (m *SingleMessageMetadata) GetEventTime() uint64 {
+	if m != nil && m.EventTime != nil {
+		return *m.EventTime
+	}
+	return 0
+}
+
+func (m *SingleMessageMetadata) GetPartitionKeyB64Encoded() bool {
+	if m != nil && m.PartitionKeyB64Encoded != nil {
+		return *m.PartitionKeyB64Encoded
+	}
+	return false
+}
+
+func (m *SingleMessageMetadata) GetSequenceId() uint64 { //nolint:revive,stylecheck
+	if m != nil && m.SequenceId != nil {
+		return *m.SequenceId
+	}
+	return 0
+}
+
 // nolint
 type KeyValue struct {
 	Key                  *string  `protobuf:"bytes,1,req,name=key" json:"key,omitempty"`
@@ -87,3 +158,17 @@ type KeyValue struct {
 func (m *KeyValue) Reset()         { *m = KeyValue{} }
 func (m *KeyValue) String() string { return proto.CompactTextString(m) }
 func (*KeyValue) ProtoMessage()    {}
+
+func (m *KeyValue) GetKey() string {
+	if m != nil && m.Key != nil {
+		return *m.Key
+	}
+	return ""
+}
+
+func (m *KeyValue) GetValue() string {
+	if m != nil && m.Value != nil {
+		return *m.Value
+	}
+	return ""
+}
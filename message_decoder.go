@@ -0,0 +1,342 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsaradmin
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+
+	"github.com/linkedin/goavro/v2"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// MessageDecoder decodes the payloads of peeked messages with the schema of
+// their topic, at the schema version of each message. The schemas are
+// fetched once per topic and version, and cached for the life of the
+// decoder. A message without a schema version is decoded with the latest
+// schema of its topic, which is looked up again for every such message so
+// that schema updates are seen.
+//
+// AVRO, JSON, PROTOBUF_NATIVE, KEY_VALUE and the primitive schema types are
+// supported. Records decode to a map[string]interface{}, and a key/value to
+// a map with the "key" and "value" entries.
+type MessageDecoder struct {
+	schemas Schema
+
+	mu    sync.Mutex
+	cache map[schemaKey]messageDecoder
+}
+
+type schemaKey struct {
+	topic   string
+	version int64
+}
+
+// payloadDecoder decodes a payload into a value that can be marshaled to
+// JSON.
+type payloadDecoder func(payload []byte) (interface{}, error)
+
+// messageDecoder decodes a message, whose key is part of the value of a
+// KEY_VALUE schema with the SEPARATED encoding.
+type messageDecoder func(msg *Message) (interface{}, error)
+
+// NewMessageDecoder returns a decoder fetching the schemas with the given
+// schema client, usually Client.Schemas().
+func NewMessageDecoder(schemas Schema) *MessageDecoder {
+	return &MessageDecoder{schemas: schemas, cache: make(map[schemaKey]messageDecoder)}
+}
+
+// Decode returns the payload of the message decoded with its schema.
+func (d *MessageDecoder) Decode(msg *Message) (interface{}, error) {
+	decode, err := d.decoder(msg)
+	if err != nil {
+		return nil, err
+	}
+	value, err := decode(msg)
+	if err != nil {
+		return nil, fmt.Errorf("decode message %s: %w", msg.MessageID.String(), err)
+	}
+	return value, nil
+}
+
+// DecodeJSON returns the payload of the message decoded with its schema, as
+// a JSON document.
+func (d *MessageDecoder) DecodeJSON(msg *Message) ([]byte, error) {
+	value, err := d.Decode(msg)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(value)
+}
+
+func (d *MessageDecoder) decoder(msg *Message) (messageDecoder, error) {
+	key := schemaKey{topic: schemaTopic(msg.Topic)}
+	var info *SchemaInfo
+	switch len(msg.SchemaVersion) {
+	case 0:
+		latest, err := d.schemas.GetSchemaInfoWithVersion(key.topic)
+		if err != nil {
+			return nil, fmt.Errorf("get schema of %s: %w", key.topic, err)
+		}
+		key.version, info = latest.Version, latest.SchemaInfo
+	case 8:
+		key.version = int64(binary.BigEndian.Uint64(msg.SchemaVersion))
+	default:
+		return nil, fmt.Errorf("invalid schema version %x of message %s", msg.SchemaVersion, msg.MessageID.String())
+	}
+
+	if decode, ok := d.cached(key); ok {
+		return decode, nil
+	}
+
+	// the schema is fetched without holding the lock, so that a slow fetch
+	// does not block the messages of other topics and versions
+	if info == nil {
+		var err error
+		if info, err = d.schemas.GetSchemaInfoByVersion(key.topic, key.version); err != nil {
+			return nil, fmt.Errorf("get schema of %s: %w", key.topic, err)
+		}
+	}
+	decode, err := newMessageDecoder(info)
+	if err != nil {
+		return nil, fmt.Errorf("schema of %s: %w", key.topic, err)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if cached, ok := d.cache[key]; ok {
+		return cached, nil
+	}
+	d.cache[key] = decode
+	return decode, nil
+}
+
+func (d *MessageDecoder) cached(key schemaKey) (messageDecoder, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	decode, ok := d.cache[key]
+	return decode, ok
+}
+
+// schemaTopic returns the topic holding the schema of a topic, which is the
+// partitioned topic for a partition.
+func schemaTopic(topic string) string {
+	if getPartitionIndex(topic) >= 0 {
+		return topic[:strings.LastIndex(topic, PARTITIONEDTOPICSUFFIX)]
+	}
+	return topic
+}
+
+func newMessageDecoder(info *SchemaInfo) (messageDecoder, error) {
+	if strings.ToUpper(info.Type) == "KEY_VALUE" {
+		return newKeyValueDecoder(info)
+	}
+	decode, err := newPayloadDecoder(info)
+	if err != nil {
+		return nil, err
+	}
+	return func(msg *Message) (interface{}, error) { return decode(msg.Payload) }, nil
+}
+
+func newKeyValueDecoder(info *SchemaInfo) (messageDecoder, error) {
+	kv := info.KeyValue
+	if kv == nil {
+		var err error
+		if kv, err = decodeKeyValueSchema(string(info.Schema), info.Properties); err != nil {
+			return nil, err
+		}
+	}
+	decodeKey, err := newPayloadDecoder(kv.Key)
+	if err != nil {
+		return nil, fmt.Errorf("key schema: %w", err)
+	}
+	decodeValue, err := newPayloadDecoder(kv.Value)
+	if err != nil {
+		return nil, fmt.Errorf("value schema: %w", err)
+	}
+
+	return func(msg *Message) (interface{}, error) {
+		keyData, valueData, err := splitKeyValue(msg, kv.Encoding)
+		if err != nil {
+			return nil, err
+		}
+		result := map[string]interface{}{"key": nil, "value": nil}
+		if keyData != nil {
+			if result["key"], err = decodeKey(keyData); err != nil {
+				return nil, fmt.Errorf("key: %w", err)
+			}
+		}
+		if valueData != nil {
+			if result["value"], err = decodeValue(valueData); err != nil {
+				return nil, fmt.Errorf("value: %w", err)
+			}
+		}
+		return result, nil
+	}, nil
+}
+
+// splitKeyValue returns the encoded key and value of a message with a
+// KEY_VALUE schema. An INLINE payload holds the key and the value, each
+// preceded by its length as a big-endian int32, -1 for a null one.
+func splitKeyValue(msg *Message, encoding KeyValueEncodingType) (key, value []byte, err error) {
+	if encoding == KeyValueEncodingSeparated {
+		key = []byte(msg.Key)
+		if msg.KeyBase64Encoded {
+			if key, err = base64.StdEncoding.DecodeString(msg.Key); err != nil {
+				return nil, nil, fmt.Errorf("invalid key: %w", err)
+			}
+		}
+		return key, msg.Payload, nil
+	}
+
+	payload := msg.Payload
+	next := func() ([]byte, error) {
+		if len(payload) < 4 {
+			return nil, fmt.Errorf("truncated key/value payload")
+		}
+		size := int32(binary.BigEndian.Uint32(payload))
+		payload = payload[4:]
+		if size < 0 {
+			return nil, nil
+		}
+		if int(size) > len(payload) {
+			return nil, fmt.Errorf("truncated key/value payload")
+		}
+		data := payload[:size]
+		payload = payload[size:]
+		return data, nil
+	}
+	if key, err = next(); err != nil {
+		return nil, nil, err
+	}
+	if value, err = next(); err != nil {
+		return nil, nil, err
+	}
+	return key, value, nil
+}
+
+func newPayloadDecoder(info *SchemaInfo) (payloadDecoder, error) {
+	switch strings.ToUpper(info.Type) {
+	case "AVRO":
+		codec, err := goavro.NewCodec(string(info.Schema))
+		if err != nil {
+			return nil, err
+		}
+		return func(payload []byte) (interface{}, error) {
+			value, _, err := codec.NativeFromBinary(payload)
+			return value, err
+		}, nil
+	case "JSON":
+		return decodeJSON, nil
+	case "PROTOBUF_NATIVE":
+		return newProtobufNativeDecoder(info.Schema)
+	case "STRING":
+		return func(payload []byte) (interface{}, error) { return string(payload), nil }, nil
+	case "BYTES", "NONE", "":
+		return func(payload []byte) (interface{}, error) { return payload, nil }, nil
+	case "BOOLEAN":
+		return fixedSize(1, func(b []byte) interface{} { return b[0] != 0 }), nil
+	case "INT8":
+		return fixedSize(1, func(b []byte) interface{} { return int8(b[0]) }), nil
+	case "INT16":
+		return fixedSize(2, func(b []byte) interface{} { return int16(binary.BigEndian.Uint16(b)) }), nil
+	case "INT32":
+		return fixedSize(4, func(b []byte) interface{} { return int32(binary.BigEndian.Uint32(b)) }), nil
+	case "INT64", "TIMESTAMP", "DATE", "TIME":
+		return fixedSize(8, func(b []byte) interface{} { return int64(binary.BigEndian.Uint64(b)) }), nil
+	case "FLOAT":
+		return fixedSize(4, func(b []byte) interface{} { return math.Float32frombits(binary.BigEndian.Uint32(b)) }), nil
+	case "DOUBLE":
+		return fixedSize(8, func(b []byte) interface{} { return math.Float64frombits(binary.BigEndian.Uint64(b)) }), nil
+	default:
+		return nil, fmt.Errorf("unsupported schema type %s", info.Type)
+	}
+}
+
+func decodeJSON(payload []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(payload))
+	dec.UseNumber()
+	var value interface{}
+	if err := dec.Decode(&value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func fixedSize(size int, decode func([]byte) interface{}) payloadDecoder {
+	return func(payload []byte) (interface{}, error) {
+		if len(payload) != size {
+			return nil, fmt.Errorf("expected %d bytes, got %d", size, len(payload))
+		}
+		return decode(payload), nil
+	}
+}
+
+// protobufNativeSchema is the schema definition of a PROTOBUF_NATIVE schema.
+type protobufNativeSchema struct {
+	FileDescriptorSet      string `json:"fileDescriptorSet"`
+	RootMessageTypeName    string `json:"rootMessageTypeName"`
+	RootFileDescriptorName string `json:"rootFileDescriptorName"`
+}
+
+func newProtobufNativeDecoder(schema []byte) (payloadDecoder, error) {
+	var native protobufNativeSchema
+	if err := json.Unmarshal(schema, &native); err != nil {
+		return nil, err
+	}
+	data, err := base64.StdEncoding.DecodeString(native.FileDescriptorSet)
+	if err != nil {
+		return nil, fmt.Errorf("invalid file descriptor set: %w", err)
+	}
+	var set descriptorpb.FileDescriptorSet
+	if err := proto.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("invalid file descriptor set: %w", err)
+	}
+	files, err := protodesc.NewFiles(&set)
+	if err != nil {
+		return nil, err
+	}
+	descriptor, err := files.FindDescriptorByName(protoreflect.FullName(native.RootMessageTypeName))
+	if err != nil {
+		return nil, err
+	}
+	message, ok := descriptor.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a message", native.RootMessageTypeName)
+	}
+	return func(payload []byte) (interface{}, error) {
+		msg := dynamicpb.NewMessage(message)
+		if err := proto.Unmarshal(payload, msg); err != nil {
+			return nil, err
+		}
+		data, err := protojson.Marshal(msg)
+		if err != nil {
+			return nil, err
+		}
+		return decodeJSON(data)
+	}, nil
+}
@@ -0,0 +1,247 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsaradmin
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/linkedin/goavro/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// fakeSchemas serves fixed schema versions and counts the calls.
+type fakeSchemas struct {
+	Schema
+	versions []*SchemaInfo
+	calls    map[string]int
+}
+
+func (f *fakeSchemas) GetSchemaInfoByVersion(topic string, version int64) (*SchemaInfo, error) {
+	f.calls[topic]++
+	if version >= int64(len(f.versions)) {
+		return nil, errors.New("schema not found")
+	}
+	return f.versions[version], nil
+}
+
+func (f *fakeSchemas) GetSchemaInfoWithVersion(topic string) (*SchemaInfoWithVersion, error) {
+	f.calls[topic+"@latest"]++
+	version := int64(len(f.versions) - 1)
+	return &SchemaInfoWithVersion{Version: version, SchemaInfo: f.versions[version]}, nil
+}
+
+func schemaVersion(v byte) []byte {
+	return []byte{0, 0, 0, 0, 0, 0, 0, v}
+}
+
+func TestMessageDecoderCachesSchemaVersions(t *testing.T) {
+	const topic = "persistent://public/default/orders"
+	schemas := &fakeSchemas{
+		versions: []*SchemaInfo{{Type: "STRING"}, {Type: "JSON", Schema: []byte(`{"type":"record"}`)}},
+		calls:    map[string]int{},
+	}
+	decoder := NewMessageDecoder(schemas)
+
+	for i := 0; i < 2; i++ {
+		value, err := decoder.Decode(&Message{Topic: topic + "-partition-1", Payload: []byte("text"),
+			SchemaVersion: schemaVersion(0)})
+		require.NoError(t, err)
+		assert.Equal(t, "text", value)
+
+		doc, err := decoder.DecodeJSON(&Message{Topic: topic, Payload: []byte(`{"id":12345678901234567}`),
+			SchemaVersion: schemaVersion(1)})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"id":12345678901234567}`, string(doc))
+
+		value, err = decoder.Decode(&Message{Topic: topic, Payload: []byte(`{"id":1}`)})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"id": json.Number("1")}, value)
+	}
+	// the latest schema is looked up for each message without a version, and
+	// its decoder is cached under the version it resolved to
+	assert.Equal(t, map[string]int{topic: 2, topic + "@latest": 2}, schemas.calls)
+
+	_, err := decoder.Decode(&Message{Topic: topic, SchemaVersion: schemaVersion(2)})
+	assert.ErrorContains(t, err, "schema not found")
+	_, err = decoder.Decode(&Message{Topic: topic, SchemaVersion: []byte{1}})
+	assert.ErrorContains(t, err, "invalid schema version")
+}
+
+func TestMessageDecoderFollowsLatestSchema(t *testing.T) {
+	const topic = "persistent://public/default/orders"
+	schemas := &fakeSchemas{versions: []*SchemaInfo{{Type: "STRING"}}, calls: map[string]int{}}
+	decoder := NewMessageDecoder(schemas)
+
+	value, err := decoder.Decode(&Message{Topic: topic, Payload: []byte("1")})
+	require.NoError(t, err)
+	assert.Equal(t, "1", value)
+
+	schemas.versions = append(schemas.versions, &SchemaInfo{Type: "JSON"})
+	value, err = decoder.Decode(&Message{Topic: topic, Payload: []byte("1")})
+	require.NoError(t, err)
+	assert.Equal(t, json.Number("1"), value)
+}
+
+// blockingSchemas serves a STRING schema, blocking the fetches of a topic
+// until release is closed.
+type blockingSchemas struct {
+	Schema
+	blocked string
+	release chan struct{}
+}
+
+func (b *blockingSchemas) GetSchemaInfoByVersion(topic string, version int64) (*SchemaInfo, error) {
+	if topic == b.blocked {
+		<-b.release
+	}
+	return &SchemaInfo{Type: "STRING"}, nil
+}
+
+func TestMessageDecoderFetchesWithoutBlocking(t *testing.T) {
+	schemas := &blockingSchemas{blocked: "persistent://public/default/slow", release: make(chan struct{})}
+	decoder := NewMessageDecoder(schemas)
+
+	done := make(chan error)
+	go func() {
+		_, err := decoder.Decode(&Message{Topic: schemas.blocked, SchemaVersion: schemaVersion(0)})
+		done <- err
+	}()
+
+	value, err := decoder.Decode(&Message{Topic: "persistent://public/default/fast", Payload: []byte("text"),
+		SchemaVersion: schemaVersion(0)})
+	require.NoError(t, err)
+	assert.Equal(t, "text", value)
+
+	close(schemas.release)
+	require.NoError(t, <-done)
+}
+
+func TestMessageDecoderAvro(t *testing.T) {
+	const schema = `{"type":"record","name":"Order",` +
+		`"fields":[{"name":"id","type":"long"},{"name":"item","type":"string"}]}`
+	codec, err := goavro.NewCodec(schema)
+	require.NoError(t, err)
+	payload, err := codec.BinaryFromNative(nil, map[string]interface{}{"id": int64(7), "item": "book"})
+	require.NoError(t, err)
+
+	decoder := NewMessageDecoder(&fakeSchemas{
+		versions: []*SchemaInfo{{Type: "AVRO", Schema: []byte(schema)}},
+		calls:    map[string]int{},
+	})
+	doc, err := decoder.DecodeJSON(&Message{Topic: "persistent://public/default/orders", Payload: payload,
+		SchemaVersion: schemaVersion(0)})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":7,"item":"book"}`, string(doc))
+}
+
+func TestMessageDecoderProtobufNative(t *testing.T) {
+	file := protodesc.ToFileDescriptorProto(descriptorpb.File_google_protobuf_descriptor_proto)
+	set, err := proto.Marshal(&descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{file}})
+	require.NoError(t, err)
+	schema, err := json.Marshal(protobufNativeSchema{
+		FileDescriptorSet:      base64.StdEncoding.EncodeToString(set),
+		RootMessageTypeName:    "google.protobuf.FieldDescriptorProto",
+		RootFileDescriptorName: file.GetName(),
+	})
+	require.NoError(t, err)
+	payload, err := proto.Marshal(&descriptorpb.FieldDescriptorProto{Name: proto.String("id"), Number: proto.Int32(1)})
+	require.NoError(t, err)
+
+	decoder := NewMessageDecoder(&fakeSchemas{
+		versions: []*SchemaInfo{{Type: "PROTOBUF_NATIVE", Schema: schema}},
+		calls:    map[string]int{},
+	})
+	value, err := decoder.Decode(&Message{Topic: "persistent://public/default/orders", Payload: payload})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"name": "id", "number": json.Number("1")}, value)
+}
+
+func TestMessageDecoderPrimitives(t *testing.T) {
+	for _, tc := range []struct {
+		schemaType string
+		payload    []byte
+		want       interface{}
+	}{
+		{"BOOLEAN", []byte{1}, true},
+		{"INT8", []byte{0xff}, int8(-1)},
+		{"INT16", []byte{0x01, 0x00}, int16(256)},
+		{"INT32", []byte{0, 0, 0x01, 0x00}, int32(256)},
+		{"INT64", []byte{0, 0, 0, 0, 0, 0, 0x01, 0x00}, int64(256)},
+		{"DOUBLE", []byte{0x3f, 0xf8, 0, 0, 0, 0, 0, 0}, 1.5},
+		{"BYTES", []byte{1, 2}, []byte{1, 2}},
+	} {
+		t.Run(tc.schemaType, func(t *testing.T) {
+			decoder := NewMessageDecoder(&fakeSchemas{
+				versions: []*SchemaInfo{{Type: tc.schemaType}},
+				calls:    map[string]int{},
+			})
+			value, err := decoder.Decode(&Message{Topic: "persistent://public/default/orders", Payload: tc.payload})
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, value)
+		})
+	}
+
+	decoder := NewMessageDecoder(&fakeSchemas{versions: []*SchemaInfo{{Type: "INT32"}}, calls: map[string]int{}})
+	_, err := decoder.Decode(&Message{Topic: "persistent://public/default/orders", Payload: []byte{1}})
+	assert.ErrorContains(t, err, "expected 4 bytes, got 1")
+
+	decoder = NewMessageDecoder(&fakeSchemas{versions: []*SchemaInfo{{Type: "AUTO"}}, calls: map[string]int{}})
+	_, err = decoder.Decode(&Message{Topic: "persistent://public/default/orders"})
+	assert.ErrorContains(t, err, "unsupported schema type AUTO")
+}
+
+func TestMessageDecoderKeyValue(t *testing.T) {
+	kv := &KeyValueSchemaInfo{
+		Key:   &SchemaInfo{Type: "STRING"},
+		Value: &SchemaInfo{Type: "JSON", Schema: []byte(`{"type":"record"}`)},
+	}
+	const topic = "persistent://public/default/orders"
+	decoder := NewMessageDecoder(&fakeSchemas{
+		versions: []*SchemaInfo{{Type: "KEY_VALUE", KeyValue: kv}},
+		calls:    map[string]int{},
+	})
+
+	payload := []byte{0, 0, 0, 3, 'k', 'e', 'y', 0, 0, 0, 8}
+	payload = append(payload, `{"id":1}`...)
+	doc, err := decoder.DecodeJSON(&Message{Topic: topic, Payload: payload, SchemaVersion: schemaVersion(0)})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"key":"key","value":{"id":1}}`, string(doc))
+
+	doc, err = decoder.DecodeJSON(&Message{Topic: topic, Payload: []byte{0xff, 0xff, 0xff, 0xff, 0, 0, 0, 2, '{', '}'},
+		SchemaVersion: schemaVersion(0)})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"key":null,"value":{}}`, string(doc))
+
+	_, err = decoder.Decode(&Message{Topic: topic, Payload: []byte{0, 0, 0, 9, 'k'}, SchemaVersion: schemaVersion(0)})
+	assert.ErrorContains(t, err, "truncated key/value payload")
+
+	kv.Encoding = KeyValueEncodingSeparated
+	decoder = NewMessageDecoder(&fakeSchemas{
+		versions: []*SchemaInfo{{Type: "KEY_VALUE", KeyValue: kv}},
+		calls:    map[string]int{},
+	})
+	doc, err = decoder.DecodeJSON(&Message{Topic: topic, Key: "a2V5", KeyBase64Encoded: true,
+		Payload: []byte(`{"id":2}`)})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"key":"key","value":{"id":2}}`, string(doc))
+}
@@ -0,0 +1,209 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsaradmin
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// exportedMessage is the JSON form of a message in the exports.
+type exportedMessage struct {
+	MessageID        string            `json:"messageId"`
+	Topic            string            `json:"topic"`
+	PublishTime      *time.Time        `json:"publishTime,omitempty"`
+	EventTime        *time.Time        `json:"eventTime,omitempty"`
+	Key              string            `json:"key,omitempty"`
+	KeyBase64Encoded bool              `json:"keyBase64Encoded,omitempty"`
+	OrderingKey      []byte            `json:"orderingKey,omitempty"`
+	SequenceID       int64             `json:"sequenceId,omitempty"`
+	ProducerName     string            `json:"producerName,omitempty"`
+	RedeliveryCount  uint32            `json:"redeliveryCount,omitempty"`
+	ReplicatedFrom   string            `json:"replicatedFrom,omitempty"`
+	SchemaVersion    []byte            `json:"schemaVersion,omitempty"`
+	UUID             string            `json:"uuid,omitempty"`
+	NumChunks        int               `json:"numChunks,omitempty"`
+	Properties       map[string]string `json:"properties,omitempty"`
+	Payload          []byte            `json:"payload,omitempty"`
+}
+
+func newExportedMessage(m *Message, withPayload bool) *exportedMessage {
+	e := &exportedMessage{
+		MessageID:        m.MessageID.String(),
+		Topic:            m.Topic,
+		Key:              m.Key,
+		KeyBase64Encoded: m.KeyBase64Encoded,
+		OrderingKey:      m.OrderingKey,
+		SequenceID:       m.SequenceID,
+		ProducerName:     m.ProducerName,
+		RedeliveryCount:  m.RedeliveryCount,
+		ReplicatedFrom:   m.ReplicatedFrom,
+		SchemaVersion:    m.SchemaVersion,
+		UUID:             m.UUID,
+		NumChunks:        m.NumChunks,
+		Properties:       m.Properties,
+	}
+	if !m.PublishTime.IsZero() {
+		e.PublishTime = &m.PublishTime
+	}
+	if !m.EventTime.IsZero() {
+		e.EventTime = &m.EventTime
+	}
+	if withPayload {
+		e.Payload = m.Payload
+	}
+	return e
+}
+
+func (e *exportedMessage) message() (*Message, error) {
+	id, err := ParseMessageID(e.MessageID)
+	if err != nil {
+		return nil, err
+	}
+	m := &Message{
+		MessageID:        *id,
+		Topic:            e.Topic,
+		Key:              e.Key,
+		KeyBase64Encoded: e.KeyBase64Encoded,
+		OrderingKey:      e.OrderingKey,
+		SequenceID:       e.SequenceID,
+		ProducerName:     e.ProducerName,
+		RedeliveryCount:  e.RedeliveryCount,
+		ReplicatedFrom:   e.ReplicatedFrom,
+		SchemaVersion:    e.SchemaVersion,
+		UUID:             e.UUID,
+		NumChunks:        e.NumChunks,
+		Properties:       e.Properties,
+		Payload:          e.Payload,
+	}
+	if e.PublishTime != nil {
+		m.PublishTime = *e.PublishTime
+	}
+	if e.EventTime != nil {
+		m.EventTime = *e.EventTime
+	}
+	return m, nil
+}
+
+// WriteNDJSON writes the remaining messages of the iterator to w as
+// newline-delimited JSON, one object per message with its metadata and its
+// base64 encoded payload. It returns the number of messages written.
+func (it *MessageIterator) WriteNDJSON(w io.Writer) (int, error) {
+	enc := json.NewEncoder(w)
+	n := 0
+	for it.Next() {
+		if err := enc.Encode(newExportedMessage(it.Message(), true)); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, it.Err()
+}
+
+// MaxLengthPrefixedSize is the maximum size of the metadata or of the payload
+// of a message in the length-prefixed format. It bounds the memory allocated
+// by ReadLengthPrefixed for a corrupt or hostile input.
+const MaxLengthPrefixedSize = 256 << 20
+
+// WriteLengthPrefixed writes the remaining messages of the iterator to w in
+// a binary format, and returns the number of messages written. Each message
+// is written as the JSON of its metadata, then its raw payload, each
+// preceded by its length as a big-endian uint32, and may not exceed
+// MaxLengthPrefixedSize. ReadLengthPrefixed reads the messages back.
+func (it *MessageIterator) WriteLengthPrefixed(w io.Writer) (int, error) {
+	bw := bufio.NewWriter(w)
+	n := 0
+	for it.Next() {
+		msg := it.Message()
+		metadata, err := json.Marshal(newExportedMessage(msg, false))
+		if err != nil {
+			return n, err
+		}
+		if err := writeLengthPrefixed(bw, metadata); err != nil {
+			return n, err
+		}
+		if err := writeLengthPrefixed(bw, msg.Payload); err != nil {
+			return n, err
+		}
+		n++
+	}
+	if err := bw.Flush(); err != nil {
+		return n, err
+	}
+	return n, it.Err()
+}
+
+func writeLengthPrefixed(w io.Writer, data []byte) error {
+	if len(data) > MaxLengthPrefixedSize {
+		return fmt.Errorf("record of %d bytes exceeds the maximum of %d bytes", len(data), MaxLengthPrefixedSize)
+	}
+	if err := binary.Write(w, binary.BigEndian, uint32(len(data))); err != nil {
+		return err
+	}
+	_, err := w.Write(data)
+	return err
+}
+
+// ReadLengthPrefixed reads the next message written by WriteLengthPrefixed.
+// It returns io.EOF when there are no more messages.
+func ReadLengthPrefixed(r io.Reader) (*Message, error) {
+	metadata, err := readLengthPrefixed(r)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := readLengthPrefixed(r)
+	if err != nil {
+		return nil, noEOF(err)
+	}
+
+	var e exportedMessage
+	if err := json.Unmarshal(metadata, &e); err != nil {
+		return nil, fmt.Errorf("invalid message metadata: %w", err)
+	}
+	msg, err := e.message()
+	if err != nil {
+		return nil, err
+	}
+	msg.Payload = payload
+	return msg, nil
+}
+
+func readLengthPrefixed(r io.Reader) ([]byte, error) {
+	var size uint32
+	if err := binary.Read(r, binary.BigEndian, &size); err != nil {
+		return nil, err
+	}
+	if size > MaxLengthPrefixedSize {
+		return nil, fmt.Errorf("record of %d bytes exceeds the maximum of %d bytes", size, MaxLengthPrefixedSize)
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, noEOF(err)
+	}
+	return data, nil
+}
+
+// noEOF returns io.ErrUnexpectedEOF for io.EOF, for a record cut short.
+func noEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
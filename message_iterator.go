@@ -0,0 +1,236 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsaradmin
+
+import (
+	"fmt"
+	"time"
+)
+
+// IteratorOptions are the options of a MessageIterator.
+type IteratorOptions struct {
+	// StartMessageID is the position of the first message. On a
+	// partitioned topic, the partitions before its partition index are
+	// skipped and the following ones are walked from their start; with a
+	// negative index, it applies to every partition. Default is the first
+	// message of the topic.
+	StartMessageID *MessageID
+	// StartTime, when set, starts each partition at its first message
+	// published at or after it. It takes precedence over StartMessageID.
+	StartTime time.Time
+	// ReassembleChunks returns the chunks of a chunked message as a single
+	// message, see PeekOptions.
+	ReassembleChunks bool
+}
+
+// MessageIterator walks the messages of a persistent topic by ledger and
+// entry, without a subscription. A partitioned topic is walked one
+// partition after the other. The ledgers of a partition are listed from its
+// internal stats when the walk reaches it, so the messages published after
+// that are not returned.
+//
+//	it, err := pulsaradmin.NewMessageIterator(admin, *topic, nil)
+//	for it.Next() {
+//		msg := it.Message()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type MessageIterator struct {
+	client  Client
+	options IteratorOptions
+
+	// partitions are the partitions left to walk.
+	partitions []TopicName
+	// partition is the partition being walked, and partitionIndex its
+	// index, -1 for a non-partitioned topic.
+	partition      TopicName
+	partitionIndex int
+	// start is the position of the first message in the partition being
+	// walked.
+	start   MessageID
+	ledgers []ledgerEntries
+	entryID int64
+	// reassembled holds the uuids of the chunked messages returned in the
+	// current partition, whose other chunks are skipped, including those
+	// in the following ledgers.
+	reassembled map[string]bool
+
+	pending []*Message
+	msg     *Message
+	err     error
+}
+
+// ledgerEntries is a ledger and its number of entries.
+type ledgerEntries struct {
+	ledgerID int64
+	entries  int64
+}
+
+// NewMessageIterator returns an iterator over the messages of a topic.
+// Options may be nil.
+func NewMessageIterator(client Client, topic TopicName, options *IteratorOptions) (*MessageIterator, error) {
+	it := &MessageIterator{client: client}
+	if options != nil {
+		it.options = *options
+	}
+
+	metadata, err := client.Topics().GetMetadata(topic)
+	if err != nil {
+		return nil, err
+	}
+	if metadata.Partitions == 0 {
+		it.partitions = []TopicName{topic}
+		return it, nil
+	}
+	for i := 0; i < metadata.Partitions; i++ {
+		partition, err := topic.GetPartition(i)
+		if err != nil {
+			return nil, err
+		}
+		it.partitions = append(it.partitions, *partition)
+	}
+	return it, nil
+}
+
+// Next advances to the next message, and reports whether there is one. It
+// returns false at the end of the topic or on an error, see Err.
+func (it *MessageIterator) Next() bool {
+	it.msg = nil
+	for it.err == nil {
+		if len(it.pending) > 0 {
+			it.msg, it.pending = it.pending[0], it.pending[1:]
+			return true
+		}
+		if len(it.ledgers) == 0 {
+			if len(it.partitions) == 0 {
+				return false
+			}
+			it.err = it.nextPartition()
+			continue
+		}
+
+		ledger := it.ledgers[0]
+		if it.entryID >= ledger.entries {
+			it.ledgers = it.ledgers[1:]
+			it.entryID = 0
+			continue
+		}
+		entryID := it.entryID
+		it.entryID++
+		it.pending, it.err = it.readEntry(ledger.ledgerID, entryID)
+	}
+	return false
+}
+
+// Message returns the current message.
+func (it *MessageIterator) Message() *Message {
+	return it.msg
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *MessageIterator) Err() error {
+	return it.err
+}
+
+// nextPartition lists the ledgers of the next partition, from the start
+// position.
+func (it *MessageIterator) nextPartition() error {
+	it.partition, it.partitions = it.partitions[0], it.partitions[1:]
+	it.partitionIndex = getPartitionIndex(it.partition.String())
+	it.ledgers, it.entryID = nil, 0
+	it.reassembled = make(map[string]bool)
+
+	it.start = Earliest
+	switch {
+	case !it.options.StartTime.IsZero():
+		id, err := it.client.Topics().GetMessageID(it.partition, it.options.StartTime.UnixMilli())
+		if IsNotFound(err) {
+			// nothing published after the start time
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("get message id of %s at %s: %w", it.partition.String(), it.options.StartTime, err)
+		}
+		it.start = MessageID{LedgerID: id.LedgerID, EntryID: id.EntryID}
+	case it.options.StartMessageID != nil:
+		start := *it.options.StartMessageID
+		switch {
+		case it.partitionIndex < 0 || start.PartitionedIndex < 0 || start.PartitionedIndex == it.partitionIndex:
+			it.start = start
+		case start.PartitionedIndex > it.partitionIndex:
+			return nil
+		}
+	}
+
+	stats, err := it.client.Topics().GetInternalStats(it.partition)
+	if err != nil {
+		return fmt.Errorf("get internal stats of %s: %w", it.partition.String(), err)
+	}
+	lastConfirmed, err := ParseMessageID(stats.LastConfirmedEntry)
+	if err != nil {
+		lastConfirmed = &Earliest
+	}
+	for _, ledger := range stats.Ledgers {
+		entries := ledger.Entries
+		if ledger.LedgerID == lastConfirmed.LedgerID {
+			// the entries of the current ledger are not counted until it
+			// is closed
+			entries = lastConfirmed.EntryID + 1
+		}
+		if ledger.LedgerID < it.start.LedgerID || entries <= 0 {
+			continue
+		}
+		it.ledgers = append(it.ledgers, ledgerEntries{ledgerID: ledger.LedgerID, entries: entries})
+	}
+	if len(it.ledgers) > 0 && it.ledgers[0].ledgerID == it.start.LedgerID && it.start.EntryID > 0 {
+		it.entryID = it.start.EntryID
+	}
+	return nil
+}
+
+// readEntry returns the messages of an entry to return. An entry that no
+// longer exists is skipped.
+func (it *MessageIterator) readEntry(ledgerID, entryID int64) ([]*Message, error) {
+	options := &PeekOptions{ReassembleChunks: it.options.ReassembleChunks}
+	msgs, err := it.client.Subscriptions().GetMessagesByID(it.partition, ledgerID, entryID, options)
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read entry %d:%d of %s: %w", ledgerID, entryID, it.partition.String(), err)
+	}
+
+	first := ledgerID == it.start.LedgerID && entryID == it.start.EntryID
+	selected := msgs[:0]
+	for _, msg := range msgs {
+		msg.MessageID.PartitionedIndex = it.partitionIndex
+		if first && msg.MessageID.BatchIndex >= 0 && msg.MessageID.BatchIndex < it.start.BatchIndex {
+			continue
+		}
+		if it.options.ReassembleChunks && msg.isChunk() {
+			if it.reassembled[msg.UUID] {
+				continue
+			}
+			if len(msg.ChunkMessageIDs) > 0 {
+				it.reassembled[msg.UUID] = true
+			}
+		}
+		selected = append(selected, msg)
+	}
+	return selected, nil
+}
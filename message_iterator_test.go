@@ -0,0 +1,219 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsaradmin
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newIteratorServer serves a topic with two partitions: partition 0 has a
+// batch of two messages in entry 10:0, and single messages in 10:1, 11:0 and
+// 11:1, the current ledger; partition 1 has a single message in 20:0.
+func newIteratorServer(t *testing.T) Client {
+	t.Helper()
+	batch := batchPayload(t, batchEntry{payload: "a"}, batchEntry{payload: "b"})
+	entries := map[string]testEntry{
+		"10/entry/0": {header: map[string]string{"X-Pulsar-num-batch-message": "2"}, payload: batch},
+		"10/entry/1": {payload: []byte("c")},
+		"11/entry/0": {payload: []byte("d")},
+		"11/entry/1": {payload: []byte("e")},
+		"20/entry/0": {payload: []byte("f")},
+	}
+	stats := map[string]PersistentTopicInternalStats{
+		"orders-partition-0": {
+			Ledgers:            []LedgerInfo{{LedgerID: 10, Entries: 2}, {LedgerID: 11}},
+			LastConfirmedEntry: "11:1",
+		},
+		"orders-partition-1": {Ledgers: []LedgerInfo{{LedgerID: 20}}, LastConfirmedEntry: "20:0"},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/admin/v2/persistent/public/default/")
+		topic, rest, _ := strings.Cut(path, "/")
+		switch {
+		case rest == "partitions":
+			_, _ = w.Write([]byte(`{"partitions":2}`))
+		case rest == "internalStats":
+			_ = json.NewEncoder(w).Encode(stats[topic])
+		case strings.HasPrefix(rest, "messageid/") && topic == "orders-partition-0":
+			_, _ = w.Write([]byte(`{"ledgerId":11,"entryId":0}`))
+		case strings.HasPrefix(rest, "ledger/"):
+			entry, ok := entries[strings.TrimPrefix(rest, "ledger/")]
+			if ok {
+				w.Header().Set("X-Pulsar-Message-ID", strings.Replace(strings.TrimPrefix(rest, "ledger/"), "/entry/", ":", 1))
+				for k, v := range entry.header {
+					w.Header().Set(k, v)
+				}
+				_, _ = w.Write(entry.payload)
+				return
+			}
+			fallthrough
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"reason":"not found"}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	admin, err := NewClient(ClientConfig{WebServiceURL: server.URL})
+	require.NoError(t, err)
+	return admin
+}
+
+func iterate(t *testing.T, admin Client, options *IteratorOptions) []string {
+	t.Helper()
+	topic, err := GetTopicName("persistent://public/default/orders")
+	require.NoError(t, err)
+	it, err := NewMessageIterator(admin, *topic, options)
+	require.NoError(t, err)
+
+	var got []string
+	for it.Next() {
+		got = append(got, it.Message().MessageID.String()+"="+string(it.Message().Payload))
+	}
+	require.NoError(t, it.Err())
+	return got
+}
+
+func TestMessageIterator(t *testing.T) {
+	admin := newIteratorServer(t)
+
+	assert.Equal(t, []string{"10:0:0:0=a", "10:0:0:1=b", "10:1:0:-1=c", "11:0:0:-1=d", "11:1:0:-1=e", "20:0:1:-1=f"},
+		iterate(t, admin, nil))
+
+	start := MessageID{LedgerID: 10, EntryID: 0, PartitionedIndex: 0, BatchIndex: 1}
+	assert.Equal(t, []string{"10:0:0:1=b", "10:1:0:-1=c", "11:0:0:-1=d", "11:1:0:-1=e", "20:0:1:-1=f"},
+		iterate(t, admin, &IteratorOptions{StartMessageID: &start}))
+
+	start = MessageID{LedgerID: 20, EntryID: 0, PartitionedIndex: 1, BatchIndex: -1}
+	assert.Equal(t, []string{"20:0:1:-1=f"}, iterate(t, admin, &IteratorOptions{StartMessageID: &start}))
+
+	assert.Equal(t, []string{"11:0:0:-1=d", "11:1:0:-1=e"},
+		iterate(t, admin, &IteratorOptions{StartTime: time.Now()}))
+}
+
+func TestMessageIteratorChunksAcrossLedgers(t *testing.T) {
+	chunk := func(id int, payload string) testEntry {
+		return testEntry{header: map[string]string{
+			"X-Pulsar-uuid":                "producer-0-1",
+			"X-Pulsar-chunk-id":            strconv.Itoa(id),
+			"X-Pulsar-num-chunks-from-msg": "3",
+		}, payload: []byte(payload)}
+	}
+	// the chunked message starts in ledger 30 and ends in ledger 31, the
+	// current ledger
+	entries := map[string]testEntry{
+		"30/entry/0": chunk(0, "a"),
+		"30/entry/1": chunk(1, "b"),
+		"31/entry/0": chunk(2, "c"),
+		"31/entry/1": {payload: []byte("d")},
+	}
+	stats := PersistentTopicInternalStats{
+		Ledgers:            []LedgerInfo{{LedgerID: 30, Entries: 2}, {LedgerID: 31}},
+		LastConfirmedEntry: "31:1",
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/admin/v2/persistent/public/default/orders/")
+		switch {
+		case path == "partitions":
+			_, _ = w.Write([]byte(`{"partitions":0}`))
+		case path == "internalStats":
+			_ = json.NewEncoder(w).Encode(stats)
+		case strings.HasPrefix(path, "ledger/"):
+			position := strings.TrimPrefix(path, "ledger/")
+			entry, ok := entries[position]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"reason":"Message not found"}`))
+				return
+			}
+			w.Header().Set("X-Pulsar-Message-ID", strings.Replace(position, "/entry/", ":", 1))
+			for k, v := range entry.header {
+				w.Header().Set(k, v)
+			}
+			_, _ = w.Write(entry.payload)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	admin, err := NewClient(ClientConfig{WebServiceURL: server.URL})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"30:0:-1:-1=abc", "31:1:-1:-1=d"},
+		iterate(t, admin, &IteratorOptions{ReassembleChunks: true}))
+}
+
+func TestMessageIteratorExport(t *testing.T) {
+	admin := newIteratorServer(t)
+	topic, err := GetTopicName("persistent://public/default/orders")
+	require.NoError(t, err)
+
+	it, err := NewMessageIterator(admin, *topic, nil)
+	require.NoError(t, err)
+	var ndjson bytes.Buffer
+	n, err := it.WriteNDJSON(&ndjson)
+	require.NoError(t, err)
+	assert.Equal(t, 6, n)
+
+	scanner := bufio.NewScanner(&ndjson)
+	require.True(t, scanner.Scan())
+	assert.JSONEq(t, `{"messageId":"10:0:0:0","topic":"persistent://public/default/orders-partition-0",`+
+		`"properties":{"X-Pulsar-Num-Batch-Message":"2"},"payload":"YQ=="}`, scanner.Text())
+	lines := 1
+	for scanner.Scan() {
+		lines++
+	}
+	assert.Equal(t, 6, lines)
+
+	it, err = NewMessageIterator(admin, *topic, nil)
+	require.NoError(t, err)
+	var binary bytes.Buffer
+	n, err = it.WriteLengthPrefixed(&binary)
+	require.NoError(t, err)
+	assert.Equal(t, 6, n)
+
+	var payloads []string
+	for {
+		msg, err := ReadLengthPrefixed(&binary)
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		payloads = append(payloads, msg.MessageID.String()+"="+string(msg.Payload))
+	}
+	assert.Equal(t, []string{"10:0:0:0=a", "10:0:0:1=b", "10:1:0:-1=c", "11:0:0:-1=d", "11:1:0:-1=e", "20:0:1:-1=f"},
+		payloads)
+
+	_, err = ReadLengthPrefixed(bytes.NewReader([]byte{0, 0, 0, 2, '{', '}'}))
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+
+	// a corrupt length is rejected before anything is allocated for it
+	_, err = ReadLengthPrefixed(bytes.NewReader([]byte{0xff, 0xff, 0xff, 0xff}))
+	assert.ErrorContains(t, err, "exceeds the maximum")
+}
@@ -0,0 +1,135 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package otelpulsaradmin traces the calls made by a pulsaradmin.Client with
+// OpenTelemetry. It is a module of its own, so that the OpenTelemetry
+// dependencies are only required by the programs using it:
+//
+//	go get github.com/streamnative/pulsar-admin-go/otelpulsaradmin
+//
+// Install the hook returned by NewHook in pulsaradmin.ClientConfig.Hooks to
+// create a client span per admin operation, named after the interface method
+// such as "Namespaces.SetPersistence", and to propagate the trace context to
+// the broker:
+//
+//	client, err := pulsaradmin.NewClient(pulsaradmin.ClientConfig{
+//		Hooks: []pulsaradmin.Hook{otelpulsaradmin.NewHook()},
+//	})
+//
+// Spans are children of the span found in the context bound to the client
+// with Client.WithContext.
+package otelpulsaradmin
+
+import (
+	"context"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	pulsaradmin "github.com/streamnative/pulsar-admin-go"
+)
+
+const instrumentationName = "github.com/streamnative/pulsar-admin-go/otelpulsaradmin"
+
+// Attribute keys recorded on admin spans, in addition to the HTTP ones.
+const (
+	OperationKey = attribute.Key("pulsar.admin.operation")
+	TenantKey    = attribute.Key("pulsar.tenant")
+	NamespaceKey = attribute.Key("pulsar.namespace")
+	TopicKey     = attribute.Key("pulsar.topic")
+)
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	propagator     propagation.TextMapPropagator
+}
+
+// Option configures the hook returned by NewHook.
+type Option func(*config)
+
+// WithTracerProvider sets the provider used to create the tracer. The global
+// provider is used by default.
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = provider
+	}
+}
+
+// WithPropagator sets the propagator used to inject the trace context into the
+// requests sent to the broker. W3C trace context is used by default.
+func WithPropagator(propagator propagation.TextMapPropagator) Option {
+	return func(c *config) {
+		c.propagator = propagator
+	}
+}
+
+type hook struct {
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+}
+
+// NewHook returns a pulsaradmin.Hook that traces every admin call.
+func NewHook(opts ...Option) pulsaradmin.Hook {
+	c := &config{
+		propagator: propagation.TraceContext{},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.tracerProvider == nil {
+		c.tracerProvider = otel.GetTracerProvider()
+	}
+	return &hook{
+		tracer:     c.tracerProvider.Tracer(instrumentationName),
+		propagator: c.propagator,
+	}
+}
+
+func (h *hook) BeforeRequest(ctx context.Context, req *pulsaradmin.RequestInfo) context.Context {
+	name := req.Operation
+	if name == "" {
+		name = req.Method + " " + req.Endpoint
+	}
+
+	attrs := []attribute.KeyValue{
+		OperationKey.String(req.Operation),
+		attribute.String("http.method", req.Method),
+		attribute.String("http.target", req.Endpoint),
+	}
+	attrs = append(attrs, resourceAttributes(req.Endpoint)...)
+
+	ctx, _ = h.tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+	h.propagator.Inject(ctx, propagation.HeaderCarrier(req.Header))
+	return ctx
+}
+
+func (h *hook) AfterRequest(ctx context.Context, _ *pulsaradmin.RequestInfo, resp *pulsaradmin.ResponseInfo) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	if resp.StatusCode != 0 {
+		span.SetAttributes(attribute.Int("http.status_code", resp.StatusCode))
+	}
+	if resp.Err != nil {
+		span.RecordError(resp.Err)
+		span.SetStatus(codes.Error, resp.Err.Error())
+	} else if resp.StatusCode >= http.StatusBadRequest {
+		span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package otelpulsaradmin
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	pulsaradmin "github.com/streamnative/pulsar-admin-go"
+)
+
+func TestHookTracesAdminCalls(t *testing.T) {
+	var traceparent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("Traceparent")
+		if r.Method == http.MethodGet {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	client, err := pulsaradmin.NewClient(pulsaradmin.ClientConfig{
+		WebServiceURL: server.URL,
+		Hooks:         []pulsaradmin.Hook{NewHook(WithTracerProvider(provider))},
+	})
+	require.NoError(t, err)
+
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "reconcile")
+	err = client.WithContext(ctx).Namespaces().SetPersistence("public/default", pulsaradmin.PersistencePolicies{})
+	require.NoError(t, err)
+	topic, err := pulsaradmin.GetTopicName("persistent://public/default/my-topic")
+	require.NoError(t, err)
+	_, err = client.WithContext(ctx).Topics().GetStats(*topic)
+	require.Error(t, err)
+	parent.End()
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 3)
+
+	setPersistence := spans[0]
+	assert.Equal(t, "Namespaces.SetPersistence", setPersistence.Name)
+	assert.Equal(t, trace.SpanKindClient, setPersistence.SpanKind)
+	assert.Equal(t, parent.SpanContext().SpanID(), setPersistence.Parent.SpanID())
+	attrs := attributeMap(setPersistence.Attributes)
+	assert.Equal(t, "public", attrs[TenantKey])
+	assert.Equal(t, "public/default", attrs[NamespaceKey])
+	assert.Equal(t, int64(http.StatusNoContent), attrs["http.status_code"])
+	assert.Equal(t, codes.Unset, setPersistence.Status.Code)
+
+	getStats := spans[1]
+	assert.Equal(t, "Topics.GetStats", getStats.Name)
+	attrs = attributeMap(getStats.Attributes)
+	assert.Equal(t, "persistent://public/default/my-topic", attrs[TopicKey])
+	assert.Equal(t, "public/default", attrs[NamespaceKey])
+	assert.Equal(t, codes.Error, getStats.Status.Code)
+
+	assert.Contains(t, traceparent, getStats.SpanContext.TraceID().String())
+	assert.Contains(t, traceparent, getStats.SpanContext.SpanID().String())
+}
+
+func TestResourceAttributes(t *testing.T) {
+	testcases := []struct {
+		endpoint string
+		expected map[attribute.Key]interface{}
+	}{
+		{
+			endpoint: "/admin/v2/non-persistent/public/default/t%201/stats",
+			expected: map[attribute.Key]interface{}{
+				TenantKey: "public", NamespaceKey: "public/default", TopicKey: "non-persistent://public/default/t 1",
+			},
+		},
+		{
+			endpoint: "/admin/v2/schemas/public/default/t/schema",
+			expected: map[attribute.Key]interface{}{
+				TenantKey: "public", NamespaceKey: "public/default", TopicKey: "persistent://public/default/t",
+			},
+		},
+		{
+			endpoint: "/admin/v3/functions/public/default/fn",
+			expected: map[attribute.Key]interface{}{TenantKey: "public", NamespaceKey: "public/default"},
+		},
+		{
+			endpoint: "/admin/v3/packages/function/public/default/pkg/v1",
+			expected: map[attribute.Key]interface{}{TenantKey: "public", NamespaceKey: "public/default"},
+		},
+		{
+			endpoint: "/admin/v2/tenants/public",
+			expected: map[attribute.Key]interface{}{TenantKey: "public"},
+		},
+		{
+			endpoint: "/admin/v2/brokers/health",
+			expected: map[attribute.Key]interface{}{},
+		},
+	}
+	for _, testcase := range testcases {
+		assert.Equal(t, testcase.expected, attributeMap(resourceAttributes(testcase.endpoint)), testcase.endpoint)
+	}
+}
+
+func attributeMap(attrs []attribute.KeyValue) map[attribute.Key]interface{} {
+	m := make(map[attribute.Key]interface{}, len(attrs))
+	for _, attr := range attrs {
+		m[attr.Key] = attr.Value.AsInterface()
+	}
+	return m
+}
@@ -0,0 +1,89 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package otelpulsaradmin
+
+import (
+	"net/url"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	pulsaradmin "github.com/streamnative/pulsar-admin-go"
+)
+
+// resourceAttributes returns the tenant, namespace and topic addressed by an
+// admin endpoint such as "/admin/v2/persistent/public/default/my-topic/stats".
+func resourceAttributes(endpoint string) []attribute.KeyValue {
+	segments := strings.Split(strings.Trim(endpoint, "/"), "/")
+	if len(segments) == 0 || segments[0] != "admin" {
+		return nil
+	}
+	segments = segments[1:]
+	if len(segments) > 0 && (segments[0] == "v2" || segments[0] == "v3") {
+		segments = segments[1:]
+	}
+	if len(segments) < 2 {
+		return nil
+	}
+	for i, segment := range segments {
+		if unescaped, err := url.PathUnescape(segment); err == nil {
+			segments[i] = unescaped
+		}
+	}
+
+	resource, args := segments[0], segments[1:]
+	switch resource {
+	case "persistent", "non-persistent", "schemas":
+		if len(args) >= 3 {
+			domain := resource
+			if domain == "schemas" {
+				domain = "persistent"
+			}
+			topic, err := pulsaradmin.GetTopicName(domain + "://" + strings.Join(args[:3], "/"))
+			if err == nil {
+				return []attribute.KeyValue{
+					TenantKey.String(topic.GetTenant()),
+					NamespaceKey.String(topic.GetTenant() + "/" + topic.GetNamespace()),
+					TopicKey.String(topic.String()),
+				}
+			}
+		}
+		return namespaceAttributes(args)
+	case "namespaces", "functions", "sources", "sinks":
+		return namespaceAttributes(args)
+	case "packages":
+		if len(args) > 1 {
+			return namespaceAttributes(args[1:])
+		}
+	case "tenants":
+		return []attribute.KeyValue{TenantKey.String(args[0])}
+	}
+	return nil
+}
+
+func namespaceAttributes(args []string) []attribute.KeyValue {
+	if len(args) < 2 {
+		return nil
+	}
+	ns, err := pulsaradmin.GetNamespaceName(args[0] + "/" + args[1])
+	if err != nil {
+		return nil
+	}
+	return []attribute.KeyValue{
+		TenantKey.String(args[0]),
+		NamespaceKey.String(ns.String()),
+	}
+}
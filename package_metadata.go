@@ -22,3 +22,8 @@ type PackageMetadata struct {
 	ModificationTime int64             `json:"modificationTime,omitempty" yaml:"modificationTime"`
 	Properties       map[string]string `json:"properties,omitempty" yaml:"properties"`
 }
+
+// PackageSHA256Property is the property of the metadata of a package holding
+// the hex encoded SHA-256 checksum of the package, recorded by
+// Packages.Upload.
+const PackageSHA256Property = "sha256"
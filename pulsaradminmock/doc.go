@@ -0,0 +1,36 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package pulsaradminmock provides testify mocks of pulsaradmin.Client and of
+// the admin interfaces it returns, for unit tests of code that uses a
+// pulsaradmin.Client.
+//
+// Each mock records its calls and answers with the values programmed with On
+// and Return. Return may also be given a single function with the signature
+// of the method, which is called with the arguments of each call to compute
+// the results. The New constructors assert the expectations when the test
+// completes:
+//
+//	topics := pulsaradminmock.NewTopics(t)
+//	topics.On("Create", topic, 4).Return(nil)
+//
+//	admin := pulsaradminmock.NewClient(t)
+//	admin.On("Topics").Return(topics)
+//
+// The mocks are generated from the interfaces; run go generate in this
+// directory after changing them.
+package pulsaradminmock
+
+//go:generate go run gen.go
@@ -0,0 +1,41 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build ignore
+
+// This program regenerates mocks.go from the pulsaradmin interfaces.
+package main
+
+import (
+	"log"
+	"os"
+
+	"github.com/streamnative/pulsar-admin-go/internal/mockgen"
+)
+
+func main() {
+	src, err := mockgen.Generate(mockgen.Config{
+		Dir:        "..",
+		ImportPath: "github.com/streamnative/pulsar-admin-go",
+		Package:    "pulsaradminmock",
+		Root:       "Client",
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("mocks.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,4716 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Code generated by internal/mockgen. DO NOT EDIT.
+
+package pulsaradminmock
+
+import (
+	"context"
+	"io"
+
+	pulsaradmin "github.com/streamnative/pulsar-admin-go"
+	"github.com/stretchr/testify/mock"
+)
+
+// TestingT is the subset of testing.TB used by the mock constructors.
+type TestingT interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// BrokerStats is a mock of pulsaradmin.BrokerStats.
+type BrokerStats struct {
+	mock.Mock
+}
+
+var _ pulsaradmin.BrokerStats = (*BrokerStats)(nil)
+
+// NewBrokerStats returns a new BrokerStats mock that asserts its expectations
+// when the test completes.
+func NewBrokerStats(t TestingT) *BrokerStats {
+	m := &BrokerStats{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// GetMetrics mocks pulsaradmin.BrokerStats.GetMetrics.
+func (m *BrokerStats) GetMetrics() ([]pulsaradmin.Metrics, error) {
+	args := m.Called()
+	if fn, ok := args.Get(0).(func() ([]pulsaradmin.Metrics, error)); ok {
+		return fn()
+	}
+	var r0 []pulsaradmin.Metrics
+	if v := args.Get(0); v != nil {
+		r0 = v.([]pulsaradmin.Metrics)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetMBeans mocks pulsaradmin.BrokerStats.GetMBeans.
+func (m *BrokerStats) GetMBeans() ([]pulsaradmin.Metrics, error) {
+	args := m.Called()
+	if fn, ok := args.Get(0).(func() ([]pulsaradmin.Metrics, error)); ok {
+		return fn()
+	}
+	var r0 []pulsaradmin.Metrics
+	if v := args.Get(0); v != nil {
+		r0 = v.([]pulsaradmin.Metrics)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetTopics mocks pulsaradmin.BrokerStats.GetTopics.
+func (m *BrokerStats) GetTopics() (string, error) {
+	args := m.Called()
+	if fn, ok := args.Get(0).(func() (string, error)); ok {
+		return fn()
+	}
+	var r0 string
+	if v := args.Get(0); v != nil {
+		r0 = v.(string)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetLoadReport mocks pulsaradmin.BrokerStats.GetLoadReport.
+func (m *BrokerStats) GetLoadReport() (*pulsaradmin.LocalBrokerData, error) {
+	args := m.Called()
+	if fn, ok := args.Get(0).(func() (*pulsaradmin.LocalBrokerData, error)); ok {
+		return fn()
+	}
+	var r0 *pulsaradmin.LocalBrokerData
+	if v := args.Get(0); v != nil {
+		r0 = v.(*pulsaradmin.LocalBrokerData)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetAllocatorStats mocks pulsaradmin.BrokerStats.GetAllocatorStats.
+func (m *BrokerStats) GetAllocatorStats(allocatorName string) (*pulsaradmin.AllocatorStats, error) {
+	args := m.Called(allocatorName)
+	if fn, ok := args.Get(0).(func(string) (*pulsaradmin.AllocatorStats, error)); ok {
+		return fn(allocatorName)
+	}
+	var r0 *pulsaradmin.AllocatorStats
+	if v := args.Get(0); v != nil {
+		r0 = v.(*pulsaradmin.AllocatorStats)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// Brokers is a mock of pulsaradmin.Brokers.
+type Brokers struct {
+	mock.Mock
+}
+
+var _ pulsaradmin.Brokers = (*Brokers)(nil)
+
+// NewBrokers returns a new Brokers mock that asserts its expectations
+// when the test completes.
+func NewBrokers(t TestingT) *Brokers {
+	m := &Brokers{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// GetActiveBrokers mocks pulsaradmin.Brokers.GetActiveBrokers.
+func (m *Brokers) GetActiveBrokers(cluster string) ([]string, error) {
+	args := m.Called(cluster)
+	if fn, ok := args.Get(0).(func(string) ([]string, error)); ok {
+		return fn(cluster)
+	}
+	var r0 []string
+	if v := args.Get(0); v != nil {
+		r0 = v.([]string)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetDynamicConfigurationNames mocks pulsaradmin.Brokers.GetDynamicConfigurationNames.
+func (m *Brokers) GetDynamicConfigurationNames() ([]string, error) {
+	args := m.Called()
+	if fn, ok := args.Get(0).(func() ([]string, error)); ok {
+		return fn()
+	}
+	var r0 []string
+	if v := args.Get(0); v != nil {
+		r0 = v.([]string)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetOwnedNamespaces mocks pulsaradmin.Brokers.GetOwnedNamespaces.
+func (m *Brokers) GetOwnedNamespaces(cluster string, brokerURL string) (map[string]pulsaradmin.NamespaceOwnershipStatus, error) {
+	args := m.Called(cluster, brokerURL)
+	if fn, ok := args.Get(0).(func(string, string) (map[string]pulsaradmin.NamespaceOwnershipStatus, error)); ok {
+		return fn(cluster, brokerURL)
+	}
+	var r0 map[string]pulsaradmin.NamespaceOwnershipStatus
+	if v := args.Get(0); v != nil {
+		r0 = v.(map[string]pulsaradmin.NamespaceOwnershipStatus)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// UpdateDynamicConfiguration mocks pulsaradmin.Brokers.UpdateDynamicConfiguration.
+func (m *Brokers) UpdateDynamicConfiguration(configName string, configValue string) error {
+	args := m.Called(configName, configValue)
+	if fn, ok := args.Get(0).(func(string, string) error); ok {
+		return fn(configName, configValue)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// DeleteDynamicConfiguration mocks pulsaradmin.Brokers.DeleteDynamicConfiguration.
+func (m *Brokers) DeleteDynamicConfiguration(configName string) error {
+	args := m.Called(configName)
+	if fn, ok := args.Get(0).(func(string) error); ok {
+		return fn(configName)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetRuntimeConfigurations mocks pulsaradmin.Brokers.GetRuntimeConfigurations.
+func (m *Brokers) GetRuntimeConfigurations() (map[string]string, error) {
+	args := m.Called()
+	if fn, ok := args.Get(0).(func() (map[string]string, error)); ok {
+		return fn()
+	}
+	var r0 map[string]string
+	if v := args.Get(0); v != nil {
+		r0 = v.(map[string]string)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetInternalConfigurationData mocks pulsaradmin.Brokers.GetInternalConfigurationData.
+func (m *Brokers) GetInternalConfigurationData() (*pulsaradmin.InternalConfigurationData, error) {
+	args := m.Called()
+	if fn, ok := args.Get(0).(func() (*pulsaradmin.InternalConfigurationData, error)); ok {
+		return fn()
+	}
+	var r0 *pulsaradmin.InternalConfigurationData
+	if v := args.Get(0); v != nil {
+		r0 = v.(*pulsaradmin.InternalConfigurationData)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetAllDynamicConfigurations mocks pulsaradmin.Brokers.GetAllDynamicConfigurations.
+func (m *Brokers) GetAllDynamicConfigurations() (map[string]string, error) {
+	args := m.Called()
+	if fn, ok := args.Get(0).(func() (map[string]string, error)); ok {
+		return fn()
+	}
+	var r0 map[string]string
+	if v := args.Get(0); v != nil {
+		r0 = v.(map[string]string)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// HealthCheck mocks pulsaradmin.Brokers.HealthCheck.
+func (m *Brokers) HealthCheck() error {
+	args := m.Called()
+	if fn, ok := args.Get(0).(func() error); ok {
+		return fn()
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// Client is a mock of pulsaradmin.Client.
+type Client struct {
+	mock.Mock
+}
+
+var _ pulsaradmin.Client = (*Client)(nil)
+
+// NewClient returns a new Client mock that asserts its expectations
+// when the test completes.
+func NewClient(t TestingT) *Client {
+	m := &Client{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// Clusters mocks pulsaradmin.Client.Clusters.
+func (m *Client) Clusters() pulsaradmin.Clusters {
+	args := m.Called()
+	if fn, ok := args.Get(0).(func() pulsaradmin.Clusters); ok {
+		return fn()
+	}
+	var r0 pulsaradmin.Clusters
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.Clusters)
+	}
+	return r0
+}
+
+// Functions mocks pulsaradmin.Client.Functions.
+func (m *Client) Functions() pulsaradmin.Functions {
+	args := m.Called()
+	if fn, ok := args.Get(0).(func() pulsaradmin.Functions); ok {
+		return fn()
+	}
+	var r0 pulsaradmin.Functions
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.Functions)
+	}
+	return r0
+}
+
+// Tenants mocks pulsaradmin.Client.Tenants.
+func (m *Client) Tenants() pulsaradmin.Tenants {
+	args := m.Called()
+	if fn, ok := args.Get(0).(func() pulsaradmin.Tenants); ok {
+		return fn()
+	}
+	var r0 pulsaradmin.Tenants
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.Tenants)
+	}
+	return r0
+}
+
+// Topics mocks pulsaradmin.Client.Topics.
+func (m *Client) Topics() pulsaradmin.Topics {
+	args := m.Called()
+	if fn, ok := args.Get(0).(func() pulsaradmin.Topics); ok {
+		return fn()
+	}
+	var r0 pulsaradmin.Topics
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.Topics)
+	}
+	return r0
+}
+
+// Subscriptions mocks pulsaradmin.Client.Subscriptions.
+func (m *Client) Subscriptions() pulsaradmin.Subscriptions {
+	args := m.Called()
+	if fn, ok := args.Get(0).(func() pulsaradmin.Subscriptions); ok {
+		return fn()
+	}
+	var r0 pulsaradmin.Subscriptions
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.Subscriptions)
+	}
+	return r0
+}
+
+// Sources mocks pulsaradmin.Client.Sources.
+func (m *Client) Sources() pulsaradmin.Sources {
+	args := m.Called()
+	if fn, ok := args.Get(0).(func() pulsaradmin.Sources); ok {
+		return fn()
+	}
+	var r0 pulsaradmin.Sources
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.Sources)
+	}
+	return r0
+}
+
+// Sinks mocks pulsaradmin.Client.Sinks.
+func (m *Client) Sinks() pulsaradmin.Sinks {
+	args := m.Called()
+	if fn, ok := args.Get(0).(func() pulsaradmin.Sinks); ok {
+		return fn()
+	}
+	var r0 pulsaradmin.Sinks
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.Sinks)
+	}
+	return r0
+}
+
+// Namespaces mocks pulsaradmin.Client.Namespaces.
+func (m *Client) Namespaces() pulsaradmin.Namespaces {
+	args := m.Called()
+	if fn, ok := args.Get(0).(func() pulsaradmin.Namespaces); ok {
+		return fn()
+	}
+	var r0 pulsaradmin.Namespaces
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.Namespaces)
+	}
+	return r0
+}
+
+// Schemas mocks pulsaradmin.Client.Schemas.
+func (m *Client) Schemas() pulsaradmin.Schema {
+	args := m.Called()
+	if fn, ok := args.Get(0).(func() pulsaradmin.Schema); ok {
+		return fn()
+	}
+	var r0 pulsaradmin.Schema
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.Schema)
+	}
+	return r0
+}
+
+// NsIsolationPolicy mocks pulsaradmin.Client.NsIsolationPolicy.
+func (m *Client) NsIsolationPolicy() pulsaradmin.NsIsolationPolicy {
+	args := m.Called()
+	if fn, ok := args.Get(0).(func() pulsaradmin.NsIsolationPolicy); ok {
+		return fn()
+	}
+	var r0 pulsaradmin.NsIsolationPolicy
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.NsIsolationPolicy)
+	}
+	return r0
+}
+
+// Brokers mocks pulsaradmin.Client.Brokers.
+func (m *Client) Brokers() pulsaradmin.Brokers {
+	args := m.Called()
+	if fn, ok := args.Get(0).(func() pulsaradmin.Brokers); ok {
+		return fn()
+	}
+	var r0 pulsaradmin.Brokers
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.Brokers)
+	}
+	return r0
+}
+
+// BrokerStats mocks pulsaradmin.Client.BrokerStats.
+func (m *Client) BrokerStats() pulsaradmin.BrokerStats {
+	args := m.Called()
+	if fn, ok := args.Get(0).(func() pulsaradmin.BrokerStats); ok {
+		return fn()
+	}
+	var r0 pulsaradmin.BrokerStats
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.BrokerStats)
+	}
+	return r0
+}
+
+// ResourceQuotas mocks pulsaradmin.Client.ResourceQuotas.
+func (m *Client) ResourceQuotas() pulsaradmin.ResourceQuotas {
+	args := m.Called()
+	if fn, ok := args.Get(0).(func() pulsaradmin.ResourceQuotas); ok {
+		return fn()
+	}
+	var r0 pulsaradmin.ResourceQuotas
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.ResourceQuotas)
+	}
+	return r0
+}
+
+// FunctionsWorker mocks pulsaradmin.Client.FunctionsWorker.
+func (m *Client) FunctionsWorker() pulsaradmin.FunctionsWorker {
+	args := m.Called()
+	if fn, ok := args.Get(0).(func() pulsaradmin.FunctionsWorker); ok {
+		return fn()
+	}
+	var r0 pulsaradmin.FunctionsWorker
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.FunctionsWorker)
+	}
+	return r0
+}
+
+// Packages mocks pulsaradmin.Client.Packages.
+func (m *Client) Packages() pulsaradmin.Packages {
+	args := m.Called()
+	if fn, ok := args.Get(0).(func() pulsaradmin.Packages); ok {
+		return fn()
+	}
+	var r0 pulsaradmin.Packages
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.Packages)
+	}
+	return r0
+}
+
+// WithContext mocks pulsaradmin.Client.WithContext.
+func (m *Client) WithContext(ctx context.Context) pulsaradmin.Client {
+	args := m.Called(ctx)
+	if fn, ok := args.Get(0).(func(context.Context) pulsaradmin.Client); ok {
+		return fn(ctx)
+	}
+	var r0 pulsaradmin.Client
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.Client)
+	}
+	return r0
+}
+
+// Clusters is a mock of pulsaradmin.Clusters.
+type Clusters struct {
+	mock.Mock
+}
+
+var _ pulsaradmin.Clusters = (*Clusters)(nil)
+
+// NewClusters returns a new Clusters mock that asserts its expectations
+// when the test completes.
+func NewClusters(t TestingT) *Clusters {
+	m := &Clusters{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// List mocks pulsaradmin.Clusters.List.
+func (m *Clusters) List() ([]string, error) {
+	args := m.Called()
+	if fn, ok := args.Get(0).(func() ([]string, error)); ok {
+		return fn()
+	}
+	var r0 []string
+	if v := args.Get(0); v != nil {
+		r0 = v.([]string)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// Get mocks pulsaradmin.Clusters.Get.
+func (m *Clusters) Get(a0 string) (pulsaradmin.ClusterData, error) {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(string) (pulsaradmin.ClusterData, error)); ok {
+		return fn(a0)
+	}
+	var r0 pulsaradmin.ClusterData
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.ClusterData)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// Create mocks pulsaradmin.Clusters.Create.
+func (m *Clusters) Create(a0 pulsaradmin.ClusterData) error {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.ClusterData) error); ok {
+		return fn(a0)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// Delete mocks pulsaradmin.Clusters.Delete.
+func (m *Clusters) Delete(a0 string) error {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(string) error); ok {
+		return fn(a0)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// Update mocks pulsaradmin.Clusters.Update.
+func (m *Clusters) Update(a0 pulsaradmin.ClusterData) error {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.ClusterData) error); ok {
+		return fn(a0)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// UpdatePeerClusters mocks pulsaradmin.Clusters.UpdatePeerClusters.
+func (m *Clusters) UpdatePeerClusters(a0 string, a1 []string) error {
+	args := m.Called(a0, a1)
+	if fn, ok := args.Get(0).(func(string, []string) error); ok {
+		return fn(a0, a1)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetPeerClusters mocks pulsaradmin.Clusters.GetPeerClusters.
+func (m *Clusters) GetPeerClusters(a0 string) ([]string, error) {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(string) ([]string, error)); ok {
+		return fn(a0)
+	}
+	var r0 []string
+	if v := args.Get(0); v != nil {
+		r0 = v.([]string)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// CreateFailureDomain mocks pulsaradmin.Clusters.CreateFailureDomain.
+func (m *Clusters) CreateFailureDomain(a0 pulsaradmin.FailureDomainData) error {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.FailureDomainData) error); ok {
+		return fn(a0)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetFailureDomain mocks pulsaradmin.Clusters.GetFailureDomain.
+func (m *Clusters) GetFailureDomain(clusterName string, domainName string) (pulsaradmin.FailureDomainData, error) {
+	args := m.Called(clusterName, domainName)
+	if fn, ok := args.Get(0).(func(string, string) (pulsaradmin.FailureDomainData, error)); ok {
+		return fn(clusterName, domainName)
+	}
+	var r0 pulsaradmin.FailureDomainData
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.FailureDomainData)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// ListFailureDomains mocks pulsaradmin.Clusters.ListFailureDomains.
+func (m *Clusters) ListFailureDomains(a0 string) (pulsaradmin.FailureDomainMap, error) {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(string) (pulsaradmin.FailureDomainMap, error)); ok {
+		return fn(a0)
+	}
+	var r0 pulsaradmin.FailureDomainMap
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.FailureDomainMap)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// DeleteFailureDomain mocks pulsaradmin.Clusters.DeleteFailureDomain.
+func (m *Clusters) DeleteFailureDomain(a0 pulsaradmin.FailureDomainData) error {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.FailureDomainData) error); ok {
+		return fn(a0)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// UpdateFailureDomain mocks pulsaradmin.Clusters.UpdateFailureDomain.
+func (m *Clusters) UpdateFailureDomain(a0 pulsaradmin.FailureDomainData) error {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.FailureDomainData) error); ok {
+		return fn(a0)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// Functions is a mock of pulsaradmin.Functions.
+type Functions struct {
+	mock.Mock
+}
+
+var _ pulsaradmin.Functions = (*Functions)(nil)
+
+// NewFunctions returns a new Functions mock that asserts its expectations
+// when the test completes.
+func NewFunctions(t TestingT) *Functions {
+	m := &Functions{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// CreateFunc mocks pulsaradmin.Functions.CreateFunc.
+func (m *Functions) CreateFunc(data *pulsaradmin.FunctionConfig, fileName string) error {
+	args := m.Called(data, fileName)
+	if fn, ok := args.Get(0).(func(*pulsaradmin.FunctionConfig, string) error); ok {
+		return fn(data, fileName)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// CreateFuncWithReader mocks pulsaradmin.Functions.CreateFuncWithReader.
+func (m *Functions) CreateFuncWithReader(data *pulsaradmin.FunctionConfig, fileName string, r io.Reader, options *pulsaradmin.UploadOptions) error {
+	args := m.Called(data, fileName, r, options)
+	if fn, ok := args.Get(0).(func(*pulsaradmin.FunctionConfig, string, io.Reader, *pulsaradmin.UploadOptions) error); ok {
+		return fn(data, fileName, r, options)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// CreateFuncWithURL mocks pulsaradmin.Functions.CreateFuncWithURL.
+func (m *Functions) CreateFuncWithURL(data *pulsaradmin.FunctionConfig, pkgURL string) error {
+	args := m.Called(data, pkgURL)
+	if fn, ok := args.Get(0).(func(*pulsaradmin.FunctionConfig, string) error); ok {
+		return fn(data, pkgURL)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// StopFunction mocks pulsaradmin.Functions.StopFunction.
+func (m *Functions) StopFunction(tenant string, namespace string, name string) error {
+	args := m.Called(tenant, namespace, name)
+	if fn, ok := args.Get(0).(func(string, string, string) error); ok {
+		return fn(tenant, namespace, name)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// StopFunctionWithID mocks pulsaradmin.Functions.StopFunctionWithID.
+func (m *Functions) StopFunctionWithID(tenant string, namespace string, name string, instanceID int) error {
+	args := m.Called(tenant, namespace, name, instanceID)
+	if fn, ok := args.Get(0).(func(string, string, string, int) error); ok {
+		return fn(tenant, namespace, name, instanceID)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// DeleteFunction mocks pulsaradmin.Functions.DeleteFunction.
+func (m *Functions) DeleteFunction(tenant string, namespace string, name string) error {
+	args := m.Called(tenant, namespace, name)
+	if fn, ok := args.Get(0).(func(string, string, string) error); ok {
+		return fn(tenant, namespace, name)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// DownloadFunction mocks pulsaradmin.Functions.DownloadFunction.
+func (m *Functions) DownloadFunction(path string, destinationFile string) error {
+	args := m.Called(path, destinationFile)
+	if fn, ok := args.Get(0).(func(string, string) error); ok {
+		return fn(path, destinationFile)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// DownloadFunctionByNs mocks pulsaradmin.Functions.DownloadFunctionByNs.
+func (m *Functions) DownloadFunctionByNs(destinationFile string, tenant string, namespace string, function string) error {
+	args := m.Called(destinationFile, tenant, namespace, function)
+	if fn, ok := args.Get(0).(func(string, string, string, string) error); ok {
+		return fn(destinationFile, tenant, namespace, function)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// StartFunction mocks pulsaradmin.Functions.StartFunction.
+func (m *Functions) StartFunction(tenant string, namespace string, name string) error {
+	args := m.Called(tenant, namespace, name)
+	if fn, ok := args.Get(0).(func(string, string, string) error); ok {
+		return fn(tenant, namespace, name)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// StartFunctionWithID mocks pulsaradmin.Functions.StartFunctionWithID.
+func (m *Functions) StartFunctionWithID(tenant string, namespace string, name string, instanceID int) error {
+	args := m.Called(tenant, namespace, name, instanceID)
+	if fn, ok := args.Get(0).(func(string, string, string, int) error); ok {
+		return fn(tenant, namespace, name, instanceID)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// RestartFunction mocks pulsaradmin.Functions.RestartFunction.
+func (m *Functions) RestartFunction(tenant string, namespace string, name string) error {
+	args := m.Called(tenant, namespace, name)
+	if fn, ok := args.Get(0).(func(string, string, string) error); ok {
+		return fn(tenant, namespace, name)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// RestartFunctionWithID mocks pulsaradmin.Functions.RestartFunctionWithID.
+func (m *Functions) RestartFunctionWithID(tenant string, namespace string, name string, instanceID int) error {
+	args := m.Called(tenant, namespace, name, instanceID)
+	if fn, ok := args.Get(0).(func(string, string, string, int) error); ok {
+		return fn(tenant, namespace, name, instanceID)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetFunctions mocks pulsaradmin.Functions.GetFunctions.
+func (m *Functions) GetFunctions(tenant string, namespace string) ([]string, error) {
+	args := m.Called(tenant, namespace)
+	if fn, ok := args.Get(0).(func(string, string) ([]string, error)); ok {
+		return fn(tenant, namespace)
+	}
+	var r0 []string
+	if v := args.Get(0); v != nil {
+		r0 = v.([]string)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetFunction mocks pulsaradmin.Functions.GetFunction.
+func (m *Functions) GetFunction(tenant string, namespace string, name string) (pulsaradmin.FunctionConfig, error) {
+	args := m.Called(tenant, namespace, name)
+	if fn, ok := args.Get(0).(func(string, string, string) (pulsaradmin.FunctionConfig, error)); ok {
+		return fn(tenant, namespace, name)
+	}
+	var r0 pulsaradmin.FunctionConfig
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.FunctionConfig)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetFunctionStatus mocks pulsaradmin.Functions.GetFunctionStatus.
+func (m *Functions) GetFunctionStatus(tenant string, namespace string, name string) (pulsaradmin.FunctionStatus, error) {
+	args := m.Called(tenant, namespace, name)
+	if fn, ok := args.Get(0).(func(string, string, string) (pulsaradmin.FunctionStatus, error)); ok {
+		return fn(tenant, namespace, name)
+	}
+	var r0 pulsaradmin.FunctionStatus
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.FunctionStatus)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetFunctionStatusWithInstanceID mocks pulsaradmin.Functions.GetFunctionStatusWithInstanceID.
+func (m *Functions) GetFunctionStatusWithInstanceID(tenant string, namespace string, name string, instanceID int) (pulsaradmin.FunctionInstanceStatusData, error) {
+	args := m.Called(tenant, namespace, name, instanceID)
+	if fn, ok := args.Get(0).(func(string, string, string, int) (pulsaradmin.FunctionInstanceStatusData, error)); ok {
+		return fn(tenant, namespace, name, instanceID)
+	}
+	var r0 pulsaradmin.FunctionInstanceStatusData
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.FunctionInstanceStatusData)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetFunctionStats mocks pulsaradmin.Functions.GetFunctionStats.
+func (m *Functions) GetFunctionStats(tenant string, namespace string, name string) (pulsaradmin.FunctionStats, error) {
+	args := m.Called(tenant, namespace, name)
+	if fn, ok := args.Get(0).(func(string, string, string) (pulsaradmin.FunctionStats, error)); ok {
+		return fn(tenant, namespace, name)
+	}
+	var r0 pulsaradmin.FunctionStats
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.FunctionStats)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetFunctionStatsWithInstanceID mocks pulsaradmin.Functions.GetFunctionStatsWithInstanceID.
+func (m *Functions) GetFunctionStatsWithInstanceID(tenant string, namespace string, name string, instanceID int) (pulsaradmin.FunctionInstanceStatsData, error) {
+	args := m.Called(tenant, namespace, name, instanceID)
+	if fn, ok := args.Get(0).(func(string, string, string, int) (pulsaradmin.FunctionInstanceStatsData, error)); ok {
+		return fn(tenant, namespace, name, instanceID)
+	}
+	var r0 pulsaradmin.FunctionInstanceStatsData
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.FunctionInstanceStatsData)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetFunctionState mocks pulsaradmin.Functions.GetFunctionState.
+func (m *Functions) GetFunctionState(tenant string, namespace string, name string, key string) (pulsaradmin.FunctionState, error) {
+	args := m.Called(tenant, namespace, name, key)
+	if fn, ok := args.Get(0).(func(string, string, string, string) (pulsaradmin.FunctionState, error)); ok {
+		return fn(tenant, namespace, name, key)
+	}
+	var r0 pulsaradmin.FunctionState
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.FunctionState)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// PutFunctionState mocks pulsaradmin.Functions.PutFunctionState.
+func (m *Functions) PutFunctionState(tenant string, namespace string, name string, state pulsaradmin.FunctionState) error {
+	args := m.Called(tenant, namespace, name, state)
+	if fn, ok := args.Get(0).(func(string, string, string, pulsaradmin.FunctionState) error); ok {
+		return fn(tenant, namespace, name, state)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// TriggerFunction mocks pulsaradmin.Functions.TriggerFunction.
+func (m *Functions) TriggerFunction(tenant string, namespace string, name string, topic string, triggerValue string, triggerFile string) (string, error) {
+	args := m.Called(tenant, namespace, name, topic, triggerValue, triggerFile)
+	if fn, ok := args.Get(0).(func(string, string, string, string, string, string) (string, error)); ok {
+		return fn(tenant, namespace, name, topic, triggerValue, triggerFile)
+	}
+	var r0 string
+	if v := args.Get(0); v != nil {
+		r0 = v.(string)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// UpdateFunction mocks pulsaradmin.Functions.UpdateFunction.
+func (m *Functions) UpdateFunction(functionConfig *pulsaradmin.FunctionConfig, fileName string, updateOptions *pulsaradmin.UpdateOptions) error {
+	args := m.Called(functionConfig, fileName, updateOptions)
+	if fn, ok := args.Get(0).(func(*pulsaradmin.FunctionConfig, string, *pulsaradmin.UpdateOptions) error); ok {
+		return fn(functionConfig, fileName, updateOptions)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// UpdateFunctionWithReader mocks pulsaradmin.Functions.UpdateFunctionWithReader.
+func (m *Functions) UpdateFunctionWithReader(functionConfig *pulsaradmin.FunctionConfig, fileName string, r io.Reader, updateOptions *pulsaradmin.UpdateOptions, options *pulsaradmin.UploadOptions) error {
+	args := m.Called(functionConfig, fileName, r, updateOptions, options)
+	if fn, ok := args.Get(0).(func(*pulsaradmin.FunctionConfig, string, io.Reader, *pulsaradmin.UpdateOptions, *pulsaradmin.UploadOptions) error); ok {
+		return fn(functionConfig, fileName, r, updateOptions, options)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// UpdateFunctionWithURL mocks pulsaradmin.Functions.UpdateFunctionWithURL.
+func (m *Functions) UpdateFunctionWithURL(functionConfig *pulsaradmin.FunctionConfig, pkgURL string, updateOptions *pulsaradmin.UpdateOptions) error {
+	args := m.Called(functionConfig, pkgURL, updateOptions)
+	if fn, ok := args.Get(0).(func(*pulsaradmin.FunctionConfig, string, *pulsaradmin.UpdateOptions) error); ok {
+		return fn(functionConfig, pkgURL, updateOptions)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// Upload mocks pulsaradmin.Functions.Upload.
+func (m *Functions) Upload(sourceFile string, path string) error {
+	args := m.Called(sourceFile, path)
+	if fn, ok := args.Get(0).(func(string, string) error); ok {
+		return fn(sourceFile, path)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// FunctionsWorker is a mock of pulsaradmin.FunctionsWorker.
+type FunctionsWorker struct {
+	mock.Mock
+}
+
+var _ pulsaradmin.FunctionsWorker = (*FunctionsWorker)(nil)
+
+// NewFunctionsWorker returns a new FunctionsWorker mock that asserts its expectations
+// when the test completes.
+func NewFunctionsWorker(t TestingT) *FunctionsWorker {
+	m := &FunctionsWorker{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// GetFunctionsStats mocks pulsaradmin.FunctionsWorker.GetFunctionsStats.
+func (m *FunctionsWorker) GetFunctionsStats() ([]*pulsaradmin.WorkerFunctionInstanceStats, error) {
+	args := m.Called()
+	if fn, ok := args.Get(0).(func() ([]*pulsaradmin.WorkerFunctionInstanceStats, error)); ok {
+		return fn()
+	}
+	var r0 []*pulsaradmin.WorkerFunctionInstanceStats
+	if v := args.Get(0); v != nil {
+		r0 = v.([]*pulsaradmin.WorkerFunctionInstanceStats)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetMetrics mocks pulsaradmin.FunctionsWorker.GetMetrics.
+func (m *FunctionsWorker) GetMetrics() ([]*pulsaradmin.Metrics, error) {
+	args := m.Called()
+	if fn, ok := args.Get(0).(func() ([]*pulsaradmin.Metrics, error)); ok {
+		return fn()
+	}
+	var r0 []*pulsaradmin.Metrics
+	if v := args.Get(0); v != nil {
+		r0 = v.([]*pulsaradmin.Metrics)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetCluster mocks pulsaradmin.FunctionsWorker.GetCluster.
+func (m *FunctionsWorker) GetCluster() ([]*pulsaradmin.WorkerInfo, error) {
+	args := m.Called()
+	if fn, ok := args.Get(0).(func() ([]*pulsaradmin.WorkerInfo, error)); ok {
+		return fn()
+	}
+	var r0 []*pulsaradmin.WorkerInfo
+	if v := args.Get(0); v != nil {
+		r0 = v.([]*pulsaradmin.WorkerInfo)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetClusterLeader mocks pulsaradmin.FunctionsWorker.GetClusterLeader.
+func (m *FunctionsWorker) GetClusterLeader() (*pulsaradmin.WorkerInfo, error) {
+	args := m.Called()
+	if fn, ok := args.Get(0).(func() (*pulsaradmin.WorkerInfo, error)); ok {
+		return fn()
+	}
+	var r0 *pulsaradmin.WorkerInfo
+	if v := args.Get(0); v != nil {
+		r0 = v.(*pulsaradmin.WorkerInfo)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetAssignments mocks pulsaradmin.FunctionsWorker.GetAssignments.
+func (m *FunctionsWorker) GetAssignments() (map[string][]string, error) {
+	args := m.Called()
+	if fn, ok := args.Get(0).(func() (map[string][]string, error)); ok {
+		return fn()
+	}
+	var r0 map[string][]string
+	if v := args.Get(0); v != nil {
+		r0 = v.(map[string][]string)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// Namespaces is a mock of pulsaradmin.Namespaces.
+type Namespaces struct {
+	mock.Mock
+}
+
+var _ pulsaradmin.Namespaces = (*Namespaces)(nil)
+
+// NewNamespaces returns a new Namespaces mock that asserts its expectations
+// when the test completes.
+func NewNamespaces(t TestingT) *Namespaces {
+	m := &Namespaces{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// GetNamespaces mocks pulsaradmin.Namespaces.GetNamespaces.
+func (m *Namespaces) GetNamespaces(tenant string) ([]string, error) {
+	args := m.Called(tenant)
+	if fn, ok := args.Get(0).(func(string) ([]string, error)); ok {
+		return fn(tenant)
+	}
+	var r0 []string
+	if v := args.Get(0); v != nil {
+		r0 = v.([]string)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetTopics mocks pulsaradmin.Namespaces.GetTopics.
+func (m *Namespaces) GetTopics(namespace string) ([]string, error) {
+	args := m.Called(namespace)
+	if fn, ok := args.Get(0).(func(string) ([]string, error)); ok {
+		return fn(namespace)
+	}
+	var r0 []string
+	if v := args.Get(0); v != nil {
+		r0 = v.([]string)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetPolicies mocks pulsaradmin.Namespaces.GetPolicies.
+func (m *Namespaces) GetPolicies(namespace string) (*pulsaradmin.Policies, error) {
+	args := m.Called(namespace)
+	if fn, ok := args.Get(0).(func(string) (*pulsaradmin.Policies, error)); ok {
+		return fn(namespace)
+	}
+	var r0 *pulsaradmin.Policies
+	if v := args.Get(0); v != nil {
+		r0 = v.(*pulsaradmin.Policies)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// CreateNamespace mocks pulsaradmin.Namespaces.CreateNamespace.
+func (m *Namespaces) CreateNamespace(namespace string) error {
+	args := m.Called(namespace)
+	if fn, ok := args.Get(0).(func(string) error); ok {
+		return fn(namespace)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// CreateNsWithNumBundles mocks pulsaradmin.Namespaces.CreateNsWithNumBundles.
+func (m *Namespaces) CreateNsWithNumBundles(namespace string, numBundles int) error {
+	args := m.Called(namespace, numBundles)
+	if fn, ok := args.Get(0).(func(string, int) error); ok {
+		return fn(namespace, numBundles)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// CreateNsWithPolices mocks pulsaradmin.Namespaces.CreateNsWithPolices.
+func (m *Namespaces) CreateNsWithPolices(namespace string, polices pulsaradmin.Policies) error {
+	args := m.Called(namespace, polices)
+	if fn, ok := args.Get(0).(func(string, pulsaradmin.Policies) error); ok {
+		return fn(namespace, polices)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// CreateNsWithBundlesData mocks pulsaradmin.Namespaces.CreateNsWithBundlesData.
+func (m *Namespaces) CreateNsWithBundlesData(namespace string, bundleData *pulsaradmin.BundlesData) error {
+	args := m.Called(namespace, bundleData)
+	if fn, ok := args.Get(0).(func(string, *pulsaradmin.BundlesData) error); ok {
+		return fn(namespace, bundleData)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// DeleteNamespace mocks pulsaradmin.Namespaces.DeleteNamespace.
+func (m *Namespaces) DeleteNamespace(namespace string) error {
+	args := m.Called(namespace)
+	if fn, ok := args.Get(0).(func(string) error); ok {
+		return fn(namespace)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// DeleteNamespaceBundle mocks pulsaradmin.Namespaces.DeleteNamespaceBundle.
+func (m *Namespaces) DeleteNamespaceBundle(namespace string, bundleRange string) error {
+	args := m.Called(namespace, bundleRange)
+	if fn, ok := args.Get(0).(func(string, string) error); ok {
+		return fn(namespace, bundleRange)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// SetNamespaceMessageTTL mocks pulsaradmin.Namespaces.SetNamespaceMessageTTL.
+func (m *Namespaces) SetNamespaceMessageTTL(namespace string, ttlInSeconds int) error {
+	args := m.Called(namespace, ttlInSeconds)
+	if fn, ok := args.Get(0).(func(string, int) error); ok {
+		return fn(namespace, ttlInSeconds)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetNamespaceMessageTTL mocks pulsaradmin.Namespaces.GetNamespaceMessageTTL.
+func (m *Namespaces) GetNamespaceMessageTTL(namespace string) (int, error) {
+	args := m.Called(namespace)
+	if fn, ok := args.Get(0).(func(string) (int, error)); ok {
+		return fn(namespace)
+	}
+	var r0 int
+	if v := args.Get(0); v != nil {
+		r0 = v.(int)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetRetention mocks pulsaradmin.Namespaces.GetRetention.
+func (m *Namespaces) GetRetention(namespace string) (*pulsaradmin.RetentionPolicies, error) {
+	args := m.Called(namespace)
+	if fn, ok := args.Get(0).(func(string) (*pulsaradmin.RetentionPolicies, error)); ok {
+		return fn(namespace)
+	}
+	var r0 *pulsaradmin.RetentionPolicies
+	if v := args.Get(0); v != nil {
+		r0 = v.(*pulsaradmin.RetentionPolicies)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// SetRetention mocks pulsaradmin.Namespaces.SetRetention.
+func (m *Namespaces) SetRetention(namespace string, policy pulsaradmin.RetentionPolicies) error {
+	args := m.Called(namespace, policy)
+	if fn, ok := args.Get(0).(func(string, pulsaradmin.RetentionPolicies) error); ok {
+		return fn(namespace, policy)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetBacklogQuotaMap mocks pulsaradmin.Namespaces.GetBacklogQuotaMap.
+func (m *Namespaces) GetBacklogQuotaMap(namespace string) (map[pulsaradmin.BacklogQuotaType]pulsaradmin.BacklogQuota, error) {
+	args := m.Called(namespace)
+	if fn, ok := args.Get(0).(func(string) (map[pulsaradmin.BacklogQuotaType]pulsaradmin.BacklogQuota, error)); ok {
+		return fn(namespace)
+	}
+	var r0 map[pulsaradmin.BacklogQuotaType]pulsaradmin.BacklogQuota
+	if v := args.Get(0); v != nil {
+		r0 = v.(map[pulsaradmin.BacklogQuotaType]pulsaradmin.BacklogQuota)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// SetBacklogQuota mocks pulsaradmin.Namespaces.SetBacklogQuota.
+func (m *Namespaces) SetBacklogQuota(namespace string, backlogQuota pulsaradmin.BacklogQuota, backlogQuotaType pulsaradmin.BacklogQuotaType) error {
+	args := m.Called(namespace, backlogQuota, backlogQuotaType)
+	if fn, ok := args.Get(0).(func(string, pulsaradmin.BacklogQuota, pulsaradmin.BacklogQuotaType) error); ok {
+		return fn(namespace, backlogQuota, backlogQuotaType)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// RemoveBacklogQuota mocks pulsaradmin.Namespaces.RemoveBacklogQuota.
+func (m *Namespaces) RemoveBacklogQuota(namespace string) error {
+	args := m.Called(namespace)
+	if fn, ok := args.Get(0).(func(string) error); ok {
+		return fn(namespace)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// SetTopicAutoCreation mocks pulsaradmin.Namespaces.SetTopicAutoCreation.
+func (m *Namespaces) SetTopicAutoCreation(namespace pulsaradmin.NameSpaceName, config pulsaradmin.TopicAutoCreationConfig) error {
+	args := m.Called(namespace, config)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName, pulsaradmin.TopicAutoCreationConfig) error); ok {
+		return fn(namespace, config)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// RemoveTopicAutoCreation mocks pulsaradmin.Namespaces.RemoveTopicAutoCreation.
+func (m *Namespaces) RemoveTopicAutoCreation(namespace pulsaradmin.NameSpaceName) error {
+	args := m.Called(namespace)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName) error); ok {
+		return fn(namespace)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// SetSchemaValidationEnforced mocks pulsaradmin.Namespaces.SetSchemaValidationEnforced.
+func (m *Namespaces) SetSchemaValidationEnforced(namespace pulsaradmin.NameSpaceName, schemaValidationEnforced bool) error {
+	args := m.Called(namespace, schemaValidationEnforced)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName, bool) error); ok {
+		return fn(namespace, schemaValidationEnforced)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetSchemaValidationEnforced mocks pulsaradmin.Namespaces.GetSchemaValidationEnforced.
+func (m *Namespaces) GetSchemaValidationEnforced(namespace pulsaradmin.NameSpaceName) (bool, error) {
+	args := m.Called(namespace)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName) (bool, error)); ok {
+		return fn(namespace)
+	}
+	var r0 bool
+	if v := args.Get(0); v != nil {
+		r0 = v.(bool)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// SetSchemaAutoUpdateCompatibilityStrategy mocks pulsaradmin.Namespaces.SetSchemaAutoUpdateCompatibilityStrategy.
+func (m *Namespaces) SetSchemaAutoUpdateCompatibilityStrategy(namespace pulsaradmin.NameSpaceName, strategy pulsaradmin.SchemaCompatibilityStrategy) error {
+	args := m.Called(namespace, strategy)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName, pulsaradmin.SchemaCompatibilityStrategy) error); ok {
+		return fn(namespace, strategy)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetSchemaAutoUpdateCompatibilityStrategy mocks pulsaradmin.Namespaces.GetSchemaAutoUpdateCompatibilityStrategy.
+func (m *Namespaces) GetSchemaAutoUpdateCompatibilityStrategy(namespace pulsaradmin.NameSpaceName) (pulsaradmin.SchemaCompatibilityStrategy, error) {
+	args := m.Called(namespace)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName) (pulsaradmin.SchemaCompatibilityStrategy, error)); ok {
+		return fn(namespace)
+	}
+	var r0 pulsaradmin.SchemaCompatibilityStrategy
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.SchemaCompatibilityStrategy)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// ClearOffloadDeleteLag mocks pulsaradmin.Namespaces.ClearOffloadDeleteLag.
+func (m *Namespaces) ClearOffloadDeleteLag(namespace pulsaradmin.NameSpaceName) error {
+	args := m.Called(namespace)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName) error); ok {
+		return fn(namespace)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// SetOffloadDeleteLag mocks pulsaradmin.Namespaces.SetOffloadDeleteLag.
+func (m *Namespaces) SetOffloadDeleteLag(namespace pulsaradmin.NameSpaceName, timeMs int64) error {
+	args := m.Called(namespace, timeMs)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName, int64) error); ok {
+		return fn(namespace, timeMs)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetOffloadDeleteLag mocks pulsaradmin.Namespaces.GetOffloadDeleteLag.
+func (m *Namespaces) GetOffloadDeleteLag(namespace pulsaradmin.NameSpaceName) (int64, error) {
+	args := m.Called(namespace)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName) (int64, error)); ok {
+		return fn(namespace)
+	}
+	var r0 int64
+	if v := args.Get(0); v != nil {
+		r0 = v.(int64)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// SetOffloadThreshold mocks pulsaradmin.Namespaces.SetOffloadThreshold.
+func (m *Namespaces) SetOffloadThreshold(namespace pulsaradmin.NameSpaceName, threshold int64) error {
+	args := m.Called(namespace, threshold)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName, int64) error); ok {
+		return fn(namespace, threshold)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetOffloadThreshold mocks pulsaradmin.Namespaces.GetOffloadThreshold.
+func (m *Namespaces) GetOffloadThreshold(namespace pulsaradmin.NameSpaceName) (int64, error) {
+	args := m.Called(namespace)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName) (int64, error)); ok {
+		return fn(namespace)
+	}
+	var r0 int64
+	if v := args.Get(0); v != nil {
+		r0 = v.(int64)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// SetCompactionThreshold mocks pulsaradmin.Namespaces.SetCompactionThreshold.
+func (m *Namespaces) SetCompactionThreshold(namespace pulsaradmin.NameSpaceName, threshold int64) error {
+	args := m.Called(namespace, threshold)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName, int64) error); ok {
+		return fn(namespace, threshold)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetCompactionThreshold mocks pulsaradmin.Namespaces.GetCompactionThreshold.
+func (m *Namespaces) GetCompactionThreshold(namespace pulsaradmin.NameSpaceName) (int64, error) {
+	args := m.Called(namespace)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName) (int64, error)); ok {
+		return fn(namespace)
+	}
+	var r0 int64
+	if v := args.Get(0); v != nil {
+		r0 = v.(int64)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// SetMaxConsumersPerSubscription mocks pulsaradmin.Namespaces.SetMaxConsumersPerSubscription.
+func (m *Namespaces) SetMaxConsumersPerSubscription(namespace pulsaradmin.NameSpaceName, max int) error {
+	args := m.Called(namespace, max)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName, int) error); ok {
+		return fn(namespace, max)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetMaxConsumersPerSubscription mocks pulsaradmin.Namespaces.GetMaxConsumersPerSubscription.
+func (m *Namespaces) GetMaxConsumersPerSubscription(namespace pulsaradmin.NameSpaceName) (int, error) {
+	args := m.Called(namespace)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName) (int, error)); ok {
+		return fn(namespace)
+	}
+	var r0 int
+	if v := args.Get(0); v != nil {
+		r0 = v.(int)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// SetMaxConsumersPerTopic mocks pulsaradmin.Namespaces.SetMaxConsumersPerTopic.
+func (m *Namespaces) SetMaxConsumersPerTopic(namespace pulsaradmin.NameSpaceName, max int) error {
+	args := m.Called(namespace, max)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName, int) error); ok {
+		return fn(namespace, max)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetMaxConsumersPerTopic mocks pulsaradmin.Namespaces.GetMaxConsumersPerTopic.
+func (m *Namespaces) GetMaxConsumersPerTopic(namespace pulsaradmin.NameSpaceName) (int, error) {
+	args := m.Called(namespace)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName) (int, error)); ok {
+		return fn(namespace)
+	}
+	var r0 int
+	if v := args.Get(0); v != nil {
+		r0 = v.(int)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// SetMaxProducersPerTopic mocks pulsaradmin.Namespaces.SetMaxProducersPerTopic.
+func (m *Namespaces) SetMaxProducersPerTopic(namespace pulsaradmin.NameSpaceName, max int) error {
+	args := m.Called(namespace, max)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName, int) error); ok {
+		return fn(namespace, max)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetMaxProducersPerTopic mocks pulsaradmin.Namespaces.GetMaxProducersPerTopic.
+func (m *Namespaces) GetMaxProducersPerTopic(namespace pulsaradmin.NameSpaceName) (int, error) {
+	args := m.Called(namespace)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName) (int, error)); ok {
+		return fn(namespace)
+	}
+	var r0 int
+	if v := args.Get(0); v != nil {
+		r0 = v.(int)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetNamespaceReplicationClusters mocks pulsaradmin.Namespaces.GetNamespaceReplicationClusters.
+func (m *Namespaces) GetNamespaceReplicationClusters(namespace string) ([]string, error) {
+	args := m.Called(namespace)
+	if fn, ok := args.Get(0).(func(string) ([]string, error)); ok {
+		return fn(namespace)
+	}
+	var r0 []string
+	if v := args.Get(0); v != nil {
+		r0 = v.([]string)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// SetNamespaceReplicationClusters mocks pulsaradmin.Namespaces.SetNamespaceReplicationClusters.
+func (m *Namespaces) SetNamespaceReplicationClusters(namespace string, clusterIds []string) error {
+	args := m.Called(namespace, clusterIds)
+	if fn, ok := args.Get(0).(func(string, []string) error); ok {
+		return fn(namespace, clusterIds)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// SetNamespaceAntiAffinityGroup mocks pulsaradmin.Namespaces.SetNamespaceAntiAffinityGroup.
+func (m *Namespaces) SetNamespaceAntiAffinityGroup(namespace string, namespaceAntiAffinityGroup string) error {
+	args := m.Called(namespace, namespaceAntiAffinityGroup)
+	if fn, ok := args.Get(0).(func(string, string) error); ok {
+		return fn(namespace, namespaceAntiAffinityGroup)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetAntiAffinityNamespaces mocks pulsaradmin.Namespaces.GetAntiAffinityNamespaces.
+func (m *Namespaces) GetAntiAffinityNamespaces(tenant string, cluster string, namespaceAntiAffinityGroup string) ([]string, error) {
+	args := m.Called(tenant, cluster, namespaceAntiAffinityGroup)
+	if fn, ok := args.Get(0).(func(string, string, string) ([]string, error)); ok {
+		return fn(tenant, cluster, namespaceAntiAffinityGroup)
+	}
+	var r0 []string
+	if v := args.Get(0); v != nil {
+		r0 = v.([]string)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetNamespaceAntiAffinityGroup mocks pulsaradmin.Namespaces.GetNamespaceAntiAffinityGroup.
+func (m *Namespaces) GetNamespaceAntiAffinityGroup(namespace string) (string, error) {
+	args := m.Called(namespace)
+	if fn, ok := args.Get(0).(func(string) (string, error)); ok {
+		return fn(namespace)
+	}
+	var r0 string
+	if v := args.Get(0); v != nil {
+		r0 = v.(string)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// DeleteNamespaceAntiAffinityGroup mocks pulsaradmin.Namespaces.DeleteNamespaceAntiAffinityGroup.
+func (m *Namespaces) DeleteNamespaceAntiAffinityGroup(namespace string) error {
+	args := m.Called(namespace)
+	if fn, ok := args.Get(0).(func(string) error); ok {
+		return fn(namespace)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// SetDeduplicationStatus mocks pulsaradmin.Namespaces.SetDeduplicationStatus.
+func (m *Namespaces) SetDeduplicationStatus(namespace string, enableDeduplication bool) error {
+	args := m.Called(namespace, enableDeduplication)
+	if fn, ok := args.Get(0).(func(string, bool) error); ok {
+		return fn(namespace, enableDeduplication)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// SetPersistence mocks pulsaradmin.Namespaces.SetPersistence.
+func (m *Namespaces) SetPersistence(namespace string, persistence pulsaradmin.PersistencePolicies) error {
+	args := m.Called(namespace, persistence)
+	if fn, ok := args.Get(0).(func(string, pulsaradmin.PersistencePolicies) error); ok {
+		return fn(namespace, persistence)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetPersistence mocks pulsaradmin.Namespaces.GetPersistence.
+func (m *Namespaces) GetPersistence(namespace string) (*pulsaradmin.PersistencePolicies, error) {
+	args := m.Called(namespace)
+	if fn, ok := args.Get(0).(func(string) (*pulsaradmin.PersistencePolicies, error)); ok {
+		return fn(namespace)
+	}
+	var r0 *pulsaradmin.PersistencePolicies
+	if v := args.Get(0); v != nil {
+		r0 = v.(*pulsaradmin.PersistencePolicies)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// SetBookieAffinityGroup mocks pulsaradmin.Namespaces.SetBookieAffinityGroup.
+func (m *Namespaces) SetBookieAffinityGroup(namespace string, bookieAffinityGroup pulsaradmin.BookieAffinityGroupData) error {
+	args := m.Called(namespace, bookieAffinityGroup)
+	if fn, ok := args.Get(0).(func(string, pulsaradmin.BookieAffinityGroupData) error); ok {
+		return fn(namespace, bookieAffinityGroup)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// DeleteBookieAffinityGroup mocks pulsaradmin.Namespaces.DeleteBookieAffinityGroup.
+func (m *Namespaces) DeleteBookieAffinityGroup(namespace string) error {
+	args := m.Called(namespace)
+	if fn, ok := args.Get(0).(func(string) error); ok {
+		return fn(namespace)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetBookieAffinityGroup mocks pulsaradmin.Namespaces.GetBookieAffinityGroup.
+func (m *Namespaces) GetBookieAffinityGroup(namespace string) (*pulsaradmin.BookieAffinityGroupData, error) {
+	args := m.Called(namespace)
+	if fn, ok := args.Get(0).(func(string) (*pulsaradmin.BookieAffinityGroupData, error)); ok {
+		return fn(namespace)
+	}
+	var r0 *pulsaradmin.BookieAffinityGroupData
+	if v := args.Get(0); v != nil {
+		r0 = v.(*pulsaradmin.BookieAffinityGroupData)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// Unload mocks pulsaradmin.Namespaces.Unload.
+func (m *Namespaces) Unload(namespace string) error {
+	args := m.Called(namespace)
+	if fn, ok := args.Get(0).(func(string) error); ok {
+		return fn(namespace)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// UnloadNamespaceBundle mocks pulsaradmin.Namespaces.UnloadNamespaceBundle.
+func (m *Namespaces) UnloadNamespaceBundle(namespace string, bundle string) error {
+	args := m.Called(namespace, bundle)
+	if fn, ok := args.Get(0).(func(string, string) error); ok {
+		return fn(namespace, bundle)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// SplitNamespaceBundle mocks pulsaradmin.Namespaces.SplitNamespaceBundle.
+func (m *Namespaces) SplitNamespaceBundle(namespace string, bundle string, unloadSplitBundles bool) error {
+	args := m.Called(namespace, bundle, unloadSplitBundles)
+	if fn, ok := args.Get(0).(func(string, string, bool) error); ok {
+		return fn(namespace, bundle, unloadSplitBundles)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetNamespacePermissions mocks pulsaradmin.Namespaces.GetNamespacePermissions.
+func (m *Namespaces) GetNamespacePermissions(namespace pulsaradmin.NameSpaceName) (map[string][]pulsaradmin.AuthAction, error) {
+	args := m.Called(namespace)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName) (map[string][]pulsaradmin.AuthAction, error)); ok {
+		return fn(namespace)
+	}
+	var r0 map[string][]pulsaradmin.AuthAction
+	if v := args.Get(0); v != nil {
+		r0 = v.(map[string][]pulsaradmin.AuthAction)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GrantNamespacePermission mocks pulsaradmin.Namespaces.GrantNamespacePermission.
+func (m *Namespaces) GrantNamespacePermission(namespace pulsaradmin.NameSpaceName, role string, action []pulsaradmin.AuthAction) error {
+	args := m.Called(namespace, role, action)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName, string, []pulsaradmin.AuthAction) error); ok {
+		return fn(namespace, role, action)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// RevokeNamespacePermission mocks pulsaradmin.Namespaces.RevokeNamespacePermission.
+func (m *Namespaces) RevokeNamespacePermission(namespace pulsaradmin.NameSpaceName, role string) error {
+	args := m.Called(namespace, role)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName, string) error); ok {
+		return fn(namespace, role)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GrantSubPermission mocks pulsaradmin.Namespaces.GrantSubPermission.
+func (m *Namespaces) GrantSubPermission(namespace pulsaradmin.NameSpaceName, sName string, roles []string) error {
+	args := m.Called(namespace, sName, roles)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName, string, []string) error); ok {
+		return fn(namespace, sName, roles)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// RevokeSubPermission mocks pulsaradmin.Namespaces.RevokeSubPermission.
+func (m *Namespaces) RevokeSubPermission(namespace pulsaradmin.NameSpaceName, sName string, role string) error {
+	args := m.Called(namespace, sName, role)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName, string, string) error); ok {
+		return fn(namespace, sName, role)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// SetSubscriptionAuthMode mocks pulsaradmin.Namespaces.SetSubscriptionAuthMode.
+func (m *Namespaces) SetSubscriptionAuthMode(namespace pulsaradmin.NameSpaceName, mode pulsaradmin.SubscriptionAuthMode) error {
+	args := m.Called(namespace, mode)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName, pulsaradmin.SubscriptionAuthMode) error); ok {
+		return fn(namespace, mode)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// SetEncryptionRequiredStatus mocks pulsaradmin.Namespaces.SetEncryptionRequiredStatus.
+func (m *Namespaces) SetEncryptionRequiredStatus(namespace pulsaradmin.NameSpaceName, encrypt bool) error {
+	args := m.Called(namespace, encrypt)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName, bool) error); ok {
+		return fn(namespace, encrypt)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// UnsubscribeNamespace mocks pulsaradmin.Namespaces.UnsubscribeNamespace.
+func (m *Namespaces) UnsubscribeNamespace(namespace pulsaradmin.NameSpaceName, sName string) error {
+	args := m.Called(namespace, sName)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName, string) error); ok {
+		return fn(namespace, sName)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// UnsubscribeNamespaceBundle mocks pulsaradmin.Namespaces.UnsubscribeNamespaceBundle.
+func (m *Namespaces) UnsubscribeNamespaceBundle(namespace pulsaradmin.NameSpaceName, bundle string, sName string) error {
+	args := m.Called(namespace, bundle, sName)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName, string, string) error); ok {
+		return fn(namespace, bundle, sName)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// ClearNamespaceBundleBacklogForSubscription mocks pulsaradmin.Namespaces.ClearNamespaceBundleBacklogForSubscription.
+func (m *Namespaces) ClearNamespaceBundleBacklogForSubscription(namespace pulsaradmin.NameSpaceName, bundle string, sName string) error {
+	args := m.Called(namespace, bundle, sName)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName, string, string) error); ok {
+		return fn(namespace, bundle, sName)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// ClearNamespaceBundleBacklog mocks pulsaradmin.Namespaces.ClearNamespaceBundleBacklog.
+func (m *Namespaces) ClearNamespaceBundleBacklog(namespace pulsaradmin.NameSpaceName, bundle string) error {
+	args := m.Called(namespace, bundle)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName, string) error); ok {
+		return fn(namespace, bundle)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// ClearNamespaceBacklogForSubscription mocks pulsaradmin.Namespaces.ClearNamespaceBacklogForSubscription.
+func (m *Namespaces) ClearNamespaceBacklogForSubscription(namespace pulsaradmin.NameSpaceName, sName string) error {
+	args := m.Called(namespace, sName)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName, string) error); ok {
+		return fn(namespace, sName)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// ClearNamespaceBacklog mocks pulsaradmin.Namespaces.ClearNamespaceBacklog.
+func (m *Namespaces) ClearNamespaceBacklog(namespace pulsaradmin.NameSpaceName) error {
+	args := m.Called(namespace)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName) error); ok {
+		return fn(namespace)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// SetReplicatorDispatchRate mocks pulsaradmin.Namespaces.SetReplicatorDispatchRate.
+func (m *Namespaces) SetReplicatorDispatchRate(namespace pulsaradmin.NameSpaceName, rate pulsaradmin.DispatchRate) error {
+	args := m.Called(namespace, rate)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName, pulsaradmin.DispatchRate) error); ok {
+		return fn(namespace, rate)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetReplicatorDispatchRate mocks pulsaradmin.Namespaces.GetReplicatorDispatchRate.
+func (m *Namespaces) GetReplicatorDispatchRate(namespace pulsaradmin.NameSpaceName) (pulsaradmin.DispatchRate, error) {
+	args := m.Called(namespace)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName) (pulsaradmin.DispatchRate, error)); ok {
+		return fn(namespace)
+	}
+	var r0 pulsaradmin.DispatchRate
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.DispatchRate)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// SetSubscriptionDispatchRate mocks pulsaradmin.Namespaces.SetSubscriptionDispatchRate.
+func (m *Namespaces) SetSubscriptionDispatchRate(namespace pulsaradmin.NameSpaceName, rate pulsaradmin.DispatchRate) error {
+	args := m.Called(namespace, rate)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName, pulsaradmin.DispatchRate) error); ok {
+		return fn(namespace, rate)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetSubscriptionDispatchRate mocks pulsaradmin.Namespaces.GetSubscriptionDispatchRate.
+func (m *Namespaces) GetSubscriptionDispatchRate(namespace pulsaradmin.NameSpaceName) (pulsaradmin.DispatchRate, error) {
+	args := m.Called(namespace)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName) (pulsaradmin.DispatchRate, error)); ok {
+		return fn(namespace)
+	}
+	var r0 pulsaradmin.DispatchRate
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.DispatchRate)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// SetSubscribeRate mocks pulsaradmin.Namespaces.SetSubscribeRate.
+func (m *Namespaces) SetSubscribeRate(namespace pulsaradmin.NameSpaceName, rate pulsaradmin.SubscribeRate) error {
+	args := m.Called(namespace, rate)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName, pulsaradmin.SubscribeRate) error); ok {
+		return fn(namespace, rate)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetSubscribeRate mocks pulsaradmin.Namespaces.GetSubscribeRate.
+func (m *Namespaces) GetSubscribeRate(namespace pulsaradmin.NameSpaceName) (pulsaradmin.SubscribeRate, error) {
+	args := m.Called(namespace)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName) (pulsaradmin.SubscribeRate, error)); ok {
+		return fn(namespace)
+	}
+	var r0 pulsaradmin.SubscribeRate
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.SubscribeRate)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// SetDispatchRate mocks pulsaradmin.Namespaces.SetDispatchRate.
+func (m *Namespaces) SetDispatchRate(namespace pulsaradmin.NameSpaceName, rate pulsaradmin.DispatchRate) error {
+	args := m.Called(namespace, rate)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName, pulsaradmin.DispatchRate) error); ok {
+		return fn(namespace, rate)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetDispatchRate mocks pulsaradmin.Namespaces.GetDispatchRate.
+func (m *Namespaces) GetDispatchRate(namespace pulsaradmin.NameSpaceName) (pulsaradmin.DispatchRate, error) {
+	args := m.Called(namespace)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName) (pulsaradmin.DispatchRate, error)); ok {
+		return fn(namespace)
+	}
+	var r0 pulsaradmin.DispatchRate
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.DispatchRate)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// SetPublishRate mocks pulsaradmin.Namespaces.SetPublishRate.
+func (m *Namespaces) SetPublishRate(namespace pulsaradmin.NameSpaceName, pubRate pulsaradmin.PublishRate) error {
+	args := m.Called(namespace, pubRate)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName, pulsaradmin.PublishRate) error); ok {
+		return fn(namespace, pubRate)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetPublishRate mocks pulsaradmin.Namespaces.GetPublishRate.
+func (m *Namespaces) GetPublishRate(namespace pulsaradmin.NameSpaceName) (pulsaradmin.PublishRate, error) {
+	args := m.Called(namespace)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName) (pulsaradmin.PublishRate, error)); ok {
+		return fn(namespace)
+	}
+	var r0 pulsaradmin.PublishRate
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.PublishRate)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// SetIsAllowAutoUpdateSchema mocks pulsaradmin.Namespaces.SetIsAllowAutoUpdateSchema.
+func (m *Namespaces) SetIsAllowAutoUpdateSchema(namespace pulsaradmin.NameSpaceName, isAllowAutoUpdateSchema bool) error {
+	args := m.Called(namespace, isAllowAutoUpdateSchema)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName, bool) error); ok {
+		return fn(namespace, isAllowAutoUpdateSchema)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetIsAllowAutoUpdateSchema mocks pulsaradmin.Namespaces.GetIsAllowAutoUpdateSchema.
+func (m *Namespaces) GetIsAllowAutoUpdateSchema(namespace pulsaradmin.NameSpaceName) (bool, error) {
+	args := m.Called(namespace)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName) (bool, error)); ok {
+		return fn(namespace)
+	}
+	var r0 bool
+	if v := args.Get(0); v != nil {
+		r0 = v.(bool)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetInactiveTopicPolicies mocks pulsaradmin.Namespaces.GetInactiveTopicPolicies.
+func (m *Namespaces) GetInactiveTopicPolicies(namespace pulsaradmin.NameSpaceName) (pulsaradmin.InactiveTopicPolicies, error) {
+	args := m.Called(namespace)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName) (pulsaradmin.InactiveTopicPolicies, error)); ok {
+		return fn(namespace)
+	}
+	var r0 pulsaradmin.InactiveTopicPolicies
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.InactiveTopicPolicies)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// RemoveInactiveTopicPolicies mocks pulsaradmin.Namespaces.RemoveInactiveTopicPolicies.
+func (m *Namespaces) RemoveInactiveTopicPolicies(namespace pulsaradmin.NameSpaceName) error {
+	args := m.Called(namespace)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName) error); ok {
+		return fn(namespace)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// SetInactiveTopicPolicies mocks pulsaradmin.Namespaces.SetInactiveTopicPolicies.
+func (m *Namespaces) SetInactiveTopicPolicies(namespace pulsaradmin.NameSpaceName, data pulsaradmin.InactiveTopicPolicies) error {
+	args := m.Called(namespace, data)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName, pulsaradmin.InactiveTopicPolicies) error); ok {
+		return fn(namespace, data)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// NsIsolationPolicy is a mock of pulsaradmin.NsIsolationPolicy.
+type NsIsolationPolicy struct {
+	mock.Mock
+}
+
+var _ pulsaradmin.NsIsolationPolicy = (*NsIsolationPolicy)(nil)
+
+// NewNsIsolationPolicy returns a new NsIsolationPolicy mock that asserts its expectations
+// when the test completes.
+func NewNsIsolationPolicy(t TestingT) *NsIsolationPolicy {
+	m := &NsIsolationPolicy{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// CreateNamespaceIsolationPolicy mocks pulsaradmin.NsIsolationPolicy.CreateNamespaceIsolationPolicy.
+func (m *NsIsolationPolicy) CreateNamespaceIsolationPolicy(cluster string, policyName string, namespaceIsolationData pulsaradmin.NamespaceIsolationData) error {
+	args := m.Called(cluster, policyName, namespaceIsolationData)
+	if fn, ok := args.Get(0).(func(string, string, pulsaradmin.NamespaceIsolationData) error); ok {
+		return fn(cluster, policyName, namespaceIsolationData)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// DeleteNamespaceIsolationPolicy mocks pulsaradmin.NsIsolationPolicy.DeleteNamespaceIsolationPolicy.
+func (m *NsIsolationPolicy) DeleteNamespaceIsolationPolicy(cluster string, policyName string) error {
+	args := m.Called(cluster, policyName)
+	if fn, ok := args.Get(0).(func(string, string) error); ok {
+		return fn(cluster, policyName)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetNamespaceIsolationPolicy mocks pulsaradmin.NsIsolationPolicy.GetNamespaceIsolationPolicy.
+func (m *NsIsolationPolicy) GetNamespaceIsolationPolicy(cluster string, policyName string) (*pulsaradmin.NamespaceIsolationData, error) {
+	args := m.Called(cluster, policyName)
+	if fn, ok := args.Get(0).(func(string, string) (*pulsaradmin.NamespaceIsolationData, error)); ok {
+		return fn(cluster, policyName)
+	}
+	var r0 *pulsaradmin.NamespaceIsolationData
+	if v := args.Get(0); v != nil {
+		r0 = v.(*pulsaradmin.NamespaceIsolationData)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetNamespaceIsolationPolicies mocks pulsaradmin.NsIsolationPolicy.GetNamespaceIsolationPolicies.
+func (m *NsIsolationPolicy) GetNamespaceIsolationPolicies(cluster string) (map[string]pulsaradmin.NamespaceIsolationData, error) {
+	args := m.Called(cluster)
+	if fn, ok := args.Get(0).(func(string) (map[string]pulsaradmin.NamespaceIsolationData, error)); ok {
+		return fn(cluster)
+	}
+	var r0 map[string]pulsaradmin.NamespaceIsolationData
+	if v := args.Get(0); v != nil {
+		r0 = v.(map[string]pulsaradmin.NamespaceIsolationData)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetBrokersWithNamespaceIsolationPolicy mocks pulsaradmin.NsIsolationPolicy.GetBrokersWithNamespaceIsolationPolicy.
+func (m *NsIsolationPolicy) GetBrokersWithNamespaceIsolationPolicy(cluster string) ([]pulsaradmin.BrokerNamespaceIsolationData, error) {
+	args := m.Called(cluster)
+	if fn, ok := args.Get(0).(func(string) ([]pulsaradmin.BrokerNamespaceIsolationData, error)); ok {
+		return fn(cluster)
+	}
+	var r0 []pulsaradmin.BrokerNamespaceIsolationData
+	if v := args.Get(0); v != nil {
+		r0 = v.([]pulsaradmin.BrokerNamespaceIsolationData)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetBrokerWithNamespaceIsolationPolicy mocks pulsaradmin.NsIsolationPolicy.GetBrokerWithNamespaceIsolationPolicy.
+func (m *NsIsolationPolicy) GetBrokerWithNamespaceIsolationPolicy(cluster string, broker string) (*pulsaradmin.BrokerNamespaceIsolationData, error) {
+	args := m.Called(cluster, broker)
+	if fn, ok := args.Get(0).(func(string, string) (*pulsaradmin.BrokerNamespaceIsolationData, error)); ok {
+		return fn(cluster, broker)
+	}
+	var r0 *pulsaradmin.BrokerNamespaceIsolationData
+	if v := args.Get(0); v != nil {
+		r0 = v.(*pulsaradmin.BrokerNamespaceIsolationData)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// Packages is a mock of pulsaradmin.Packages.
+type Packages struct {
+	mock.Mock
+}
+
+var _ pulsaradmin.Packages = (*Packages)(nil)
+
+// NewPackages returns a new Packages mock that asserts its expectations
+// when the test completes.
+func NewPackages(t TestingT) *Packages {
+	m := &Packages{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// Download mocks pulsaradmin.Packages.Download.
+func (m *Packages) Download(packageURL string, destinationFile string) error {
+	args := m.Called(packageURL, destinationFile)
+	if fn, ok := args.Get(0).(func(string, string) error); ok {
+		return fn(packageURL, destinationFile)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// DownloadWithOptions mocks pulsaradmin.Packages.DownloadWithOptions.
+func (m *Packages) DownloadWithOptions(packageURL string, destinationFile string, options *pulsaradmin.DownloadOptions) error {
+	args := m.Called(packageURL, destinationFile, options)
+	if fn, ok := args.Get(0).(func(string, string, *pulsaradmin.DownloadOptions) error); ok {
+		return fn(packageURL, destinationFile, options)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// DownloadTo mocks pulsaradmin.Packages.DownloadTo.
+func (m *Packages) DownloadTo(ctx context.Context, packageURL string, w io.Writer, verifyChecksum bool) error {
+	args := m.Called(ctx, packageURL, w, verifyChecksum)
+	if fn, ok := args.Get(0).(func(context.Context, string, io.Writer, bool) error); ok {
+		return fn(ctx, packageURL, w, verifyChecksum)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// Upload mocks pulsaradmin.Packages.Upload.
+func (m *Packages) Upload(packageURL string, filePath string, description string, contact string, properties map[string]string) error {
+	args := m.Called(packageURL, filePath, description, contact, properties)
+	if fn, ok := args.Get(0).(func(string, string, string, string, map[string]string) error); ok {
+		return fn(packageURL, filePath, description, contact, properties)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// UploadWithReader mocks pulsaradmin.Packages.UploadWithReader.
+func (m *Packages) UploadWithReader(packageURL string, fileName string, r io.Reader, description string, contact string, properties map[string]string, options *pulsaradmin.UploadOptions) error {
+	args := m.Called(packageURL, fileName, r, description, contact, properties, options)
+	if fn, ok := args.Get(0).(func(string, string, io.Reader, string, string, map[string]string, *pulsaradmin.UploadOptions) error); ok {
+		return fn(packageURL, fileName, r, description, contact, properties, options)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// List mocks pulsaradmin.Packages.List.
+func (m *Packages) List(typeName string, namespace string) ([]string, error) {
+	args := m.Called(typeName, namespace)
+	if fn, ok := args.Get(0).(func(string, string) ([]string, error)); ok {
+		return fn(typeName, namespace)
+	}
+	var r0 []string
+	if v := args.Get(0); v != nil {
+		r0 = v.([]string)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// ListVersions mocks pulsaradmin.Packages.ListVersions.
+func (m *Packages) ListVersions(packageURL string) ([]string, error) {
+	args := m.Called(packageURL)
+	if fn, ok := args.Get(0).(func(string) ([]string, error)); ok {
+		return fn(packageURL)
+	}
+	var r0 []string
+	if v := args.Get(0); v != nil {
+		r0 = v.([]string)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// Delete mocks pulsaradmin.Packages.Delete.
+func (m *Packages) Delete(packageURL string) error {
+	args := m.Called(packageURL)
+	if fn, ok := args.Get(0).(func(string) error); ok {
+		return fn(packageURL)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetMetadata mocks pulsaradmin.Packages.GetMetadata.
+func (m *Packages) GetMetadata(packageURL string) (pulsaradmin.PackageMetadata, error) {
+	args := m.Called(packageURL)
+	if fn, ok := args.Get(0).(func(string) (pulsaradmin.PackageMetadata, error)); ok {
+		return fn(packageURL)
+	}
+	var r0 pulsaradmin.PackageMetadata
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.PackageMetadata)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// UpdateMetadata mocks pulsaradmin.Packages.UpdateMetadata.
+func (m *Packages) UpdateMetadata(packageURL string, description string, contact string, properties map[string]string) error {
+	args := m.Called(packageURL, description, contact, properties)
+	if fn, ok := args.Get(0).(func(string, string, string, map[string]string) error); ok {
+		return fn(packageURL, description, contact, properties)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// ResourceQuotas is a mock of pulsaradmin.ResourceQuotas.
+type ResourceQuotas struct {
+	mock.Mock
+}
+
+var _ pulsaradmin.ResourceQuotas = (*ResourceQuotas)(nil)
+
+// NewResourceQuotas returns a new ResourceQuotas mock that asserts its expectations
+// when the test completes.
+func NewResourceQuotas(t TestingT) *ResourceQuotas {
+	m := &ResourceQuotas{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// GetDefaultResourceQuota mocks pulsaradmin.ResourceQuotas.GetDefaultResourceQuota.
+func (m *ResourceQuotas) GetDefaultResourceQuota() (*pulsaradmin.ResourceQuota, error) {
+	args := m.Called()
+	if fn, ok := args.Get(0).(func() (*pulsaradmin.ResourceQuota, error)); ok {
+		return fn()
+	}
+	var r0 *pulsaradmin.ResourceQuota
+	if v := args.Get(0); v != nil {
+		r0 = v.(*pulsaradmin.ResourceQuota)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// SetDefaultResourceQuota mocks pulsaradmin.ResourceQuotas.SetDefaultResourceQuota.
+func (m *ResourceQuotas) SetDefaultResourceQuota(quota pulsaradmin.ResourceQuota) error {
+	args := m.Called(quota)
+	if fn, ok := args.Get(0).(func(pulsaradmin.ResourceQuota) error); ok {
+		return fn(quota)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetNamespaceBundleResourceQuota mocks pulsaradmin.ResourceQuotas.GetNamespaceBundleResourceQuota.
+func (m *ResourceQuotas) GetNamespaceBundleResourceQuota(namespace string, bundle string) (*pulsaradmin.ResourceQuota, error) {
+	args := m.Called(namespace, bundle)
+	if fn, ok := args.Get(0).(func(string, string) (*pulsaradmin.ResourceQuota, error)); ok {
+		return fn(namespace, bundle)
+	}
+	var r0 *pulsaradmin.ResourceQuota
+	if v := args.Get(0); v != nil {
+		r0 = v.(*pulsaradmin.ResourceQuota)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// SetNamespaceBundleResourceQuota mocks pulsaradmin.ResourceQuotas.SetNamespaceBundleResourceQuota.
+func (m *ResourceQuotas) SetNamespaceBundleResourceQuota(namespace string, bundle string, quota pulsaradmin.ResourceQuota) error {
+	args := m.Called(namespace, bundle, quota)
+	if fn, ok := args.Get(0).(func(string, string, pulsaradmin.ResourceQuota) error); ok {
+		return fn(namespace, bundle, quota)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// ResetNamespaceBundleResourceQuota mocks pulsaradmin.ResourceQuotas.ResetNamespaceBundleResourceQuota.
+func (m *ResourceQuotas) ResetNamespaceBundleResourceQuota(namespace string, bundle string) error {
+	args := m.Called(namespace, bundle)
+	if fn, ok := args.Get(0).(func(string, string) error); ok {
+		return fn(namespace, bundle)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// Schema is a mock of pulsaradmin.Schema.
+type Schema struct {
+	mock.Mock
+}
+
+var _ pulsaradmin.Schema = (*Schema)(nil)
+
+// NewSchema returns a new Schema mock that asserts its expectations
+// when the test completes.
+func NewSchema(t TestingT) *Schema {
+	m := &Schema{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// GetSchemaInfo mocks pulsaradmin.Schema.GetSchemaInfo.
+func (m *Schema) GetSchemaInfo(topic string) (*pulsaradmin.SchemaInfo, error) {
+	args := m.Called(topic)
+	if fn, ok := args.Get(0).(func(string) (*pulsaradmin.SchemaInfo, error)); ok {
+		return fn(topic)
+	}
+	var r0 *pulsaradmin.SchemaInfo
+	if v := args.Get(0); v != nil {
+		r0 = v.(*pulsaradmin.SchemaInfo)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetSchemaInfoWithVersion mocks pulsaradmin.Schema.GetSchemaInfoWithVersion.
+func (m *Schema) GetSchemaInfoWithVersion(topic string) (*pulsaradmin.SchemaInfoWithVersion, error) {
+	args := m.Called(topic)
+	if fn, ok := args.Get(0).(func(string) (*pulsaradmin.SchemaInfoWithVersion, error)); ok {
+		return fn(topic)
+	}
+	var r0 *pulsaradmin.SchemaInfoWithVersion
+	if v := args.Get(0); v != nil {
+		r0 = v.(*pulsaradmin.SchemaInfoWithVersion)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetSchemaInfoByVersion mocks pulsaradmin.Schema.GetSchemaInfoByVersion.
+func (m *Schema) GetSchemaInfoByVersion(topic string, version int64) (*pulsaradmin.SchemaInfo, error) {
+	args := m.Called(topic, version)
+	if fn, ok := args.Get(0).(func(string, int64) (*pulsaradmin.SchemaInfo, error)); ok {
+		return fn(topic, version)
+	}
+	var r0 *pulsaradmin.SchemaInfo
+	if v := args.Get(0); v != nil {
+		r0 = v.(*pulsaradmin.SchemaInfo)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// DeleteSchema mocks pulsaradmin.Schema.DeleteSchema.
+func (m *Schema) DeleteSchema(topic string) error {
+	args := m.Called(topic)
+	if fn, ok := args.Get(0).(func(string) error); ok {
+		return fn(topic)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// CreateSchemaByPayload mocks pulsaradmin.Schema.CreateSchemaByPayload.
+func (m *Schema) CreateSchemaByPayload(topic string, schemaPayload pulsaradmin.PostSchemaPayload) error {
+	args := m.Called(topic, schemaPayload)
+	if fn, ok := args.Get(0).(func(string, pulsaradmin.PostSchemaPayload) error); ok {
+		return fn(topic, schemaPayload)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// CreateSchemaBySchemaInfo mocks pulsaradmin.Schema.CreateSchemaBySchemaInfo.
+func (m *Schema) CreateSchemaBySchemaInfo(topic string, schemaInfo pulsaradmin.SchemaInfo) error {
+	args := m.Called(topic, schemaInfo)
+	if fn, ok := args.Get(0).(func(string, pulsaradmin.SchemaInfo) error); ok {
+		return fn(topic, schemaInfo)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// TestCompatibility mocks pulsaradmin.Schema.TestCompatibility.
+func (m *Schema) TestCompatibility(topic string, schemaPayload pulsaradmin.PostSchemaPayload) (*pulsaradmin.IsCompatibilityResponse, error) {
+	args := m.Called(topic, schemaPayload)
+	if fn, ok := args.Get(0).(func(string, pulsaradmin.PostSchemaPayload) (*pulsaradmin.IsCompatibilityResponse, error)); ok {
+		return fn(topic, schemaPayload)
+	}
+	var r0 *pulsaradmin.IsCompatibilityResponse
+	if v := args.Get(0); v != nil {
+		r0 = v.(*pulsaradmin.IsCompatibilityResponse)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetAllSchemas mocks pulsaradmin.Schema.GetAllSchemas.
+func (m *Schema) GetAllSchemas(topic string) ([]*pulsaradmin.SchemaInfoWithVersion, error) {
+	args := m.Called(topic)
+	if fn, ok := args.Get(0).(func(string) ([]*pulsaradmin.SchemaInfoWithVersion, error)); ok {
+		return fn(topic)
+	}
+	var r0 []*pulsaradmin.SchemaInfoWithVersion
+	if v := args.Get(0); v != nil {
+		r0 = v.([]*pulsaradmin.SchemaInfoWithVersion)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetVersionBySchema mocks pulsaradmin.Schema.GetVersionBySchema.
+func (m *Schema) GetVersionBySchema(topic string, schemaPayload pulsaradmin.PostSchemaPayload) (int64, error) {
+	args := m.Called(topic, schemaPayload)
+	if fn, ok := args.Get(0).(func(string, pulsaradmin.PostSchemaPayload) (int64, error)); ok {
+		return fn(topic, schemaPayload)
+	}
+	var r0 int64
+	if v := args.Get(0); v != nil {
+		r0 = v.(int64)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// Sinks is a mock of pulsaradmin.Sinks.
+type Sinks struct {
+	mock.Mock
+}
+
+var _ pulsaradmin.Sinks = (*Sinks)(nil)
+
+// NewSinks returns a new Sinks mock that asserts its expectations
+// when the test completes.
+func NewSinks(t TestingT) *Sinks {
+	m := &Sinks{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// ListSinks mocks pulsaradmin.Sinks.ListSinks.
+func (m *Sinks) ListSinks(tenant string, namespace string) ([]string, error) {
+	args := m.Called(tenant, namespace)
+	if fn, ok := args.Get(0).(func(string, string) ([]string, error)); ok {
+		return fn(tenant, namespace)
+	}
+	var r0 []string
+	if v := args.Get(0); v != nil {
+		r0 = v.([]string)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetSink mocks pulsaradmin.Sinks.GetSink.
+func (m *Sinks) GetSink(tenant string, namespace string, Sink string) (pulsaradmin.SinkConfig, error) {
+	args := m.Called(tenant, namespace, Sink)
+	if fn, ok := args.Get(0).(func(string, string, string) (pulsaradmin.SinkConfig, error)); ok {
+		return fn(tenant, namespace, Sink)
+	}
+	var r0 pulsaradmin.SinkConfig
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.SinkConfig)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// CreateSink mocks pulsaradmin.Sinks.CreateSink.
+func (m *Sinks) CreateSink(config *pulsaradmin.SinkConfig, fileName string) error {
+	args := m.Called(config, fileName)
+	if fn, ok := args.Get(0).(func(*pulsaradmin.SinkConfig, string) error); ok {
+		return fn(config, fileName)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// CreateSinkWithReader mocks pulsaradmin.Sinks.CreateSinkWithReader.
+func (m *Sinks) CreateSinkWithReader(config *pulsaradmin.SinkConfig, fileName string, r io.Reader, options *pulsaradmin.UploadOptions) error {
+	args := m.Called(config, fileName, r, options)
+	if fn, ok := args.Get(0).(func(*pulsaradmin.SinkConfig, string, io.Reader, *pulsaradmin.UploadOptions) error); ok {
+		return fn(config, fileName, r, options)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// CreateSinkWithURL mocks pulsaradmin.Sinks.CreateSinkWithURL.
+func (m *Sinks) CreateSinkWithURL(config *pulsaradmin.SinkConfig, pkgURL string) error {
+	args := m.Called(config, pkgURL)
+	if fn, ok := args.Get(0).(func(*pulsaradmin.SinkConfig, string) error); ok {
+		return fn(config, pkgURL)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// UpdateSink mocks pulsaradmin.Sinks.UpdateSink.
+func (m *Sinks) UpdateSink(config *pulsaradmin.SinkConfig, fileName string, options *pulsaradmin.UpdateOptions) error {
+	args := m.Called(config, fileName, options)
+	if fn, ok := args.Get(0).(func(*pulsaradmin.SinkConfig, string, *pulsaradmin.UpdateOptions) error); ok {
+		return fn(config, fileName, options)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// UpdateSinkWithReader mocks pulsaradmin.Sinks.UpdateSinkWithReader.
+func (m *Sinks) UpdateSinkWithReader(config *pulsaradmin.SinkConfig, fileName string, r io.Reader, options *pulsaradmin.UpdateOptions, uploadOptions *pulsaradmin.UploadOptions) error {
+	args := m.Called(config, fileName, r, options, uploadOptions)
+	if fn, ok := args.Get(0).(func(*pulsaradmin.SinkConfig, string, io.Reader, *pulsaradmin.UpdateOptions, *pulsaradmin.UploadOptions) error); ok {
+		return fn(config, fileName, r, options, uploadOptions)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// UpdateSinkWithURL mocks pulsaradmin.Sinks.UpdateSinkWithURL.
+func (m *Sinks) UpdateSinkWithURL(config *pulsaradmin.SinkConfig, pkgURL string, options *pulsaradmin.UpdateOptions) error {
+	args := m.Called(config, pkgURL, options)
+	if fn, ok := args.Get(0).(func(*pulsaradmin.SinkConfig, string, *pulsaradmin.UpdateOptions) error); ok {
+		return fn(config, pkgURL, options)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// DeleteSink mocks pulsaradmin.Sinks.DeleteSink.
+func (m *Sinks) DeleteSink(tenant string, namespace string, Sink string) error {
+	args := m.Called(tenant, namespace, Sink)
+	if fn, ok := args.Get(0).(func(string, string, string) error); ok {
+		return fn(tenant, namespace, Sink)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetSinkStatus mocks pulsaradmin.Sinks.GetSinkStatus.
+func (m *Sinks) GetSinkStatus(tenant string, namespace string, Sink string) (pulsaradmin.SinkStatus, error) {
+	args := m.Called(tenant, namespace, Sink)
+	if fn, ok := args.Get(0).(func(string, string, string) (pulsaradmin.SinkStatus, error)); ok {
+		return fn(tenant, namespace, Sink)
+	}
+	var r0 pulsaradmin.SinkStatus
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.SinkStatus)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetSinkStatusWithID mocks pulsaradmin.Sinks.GetSinkStatusWithID.
+func (m *Sinks) GetSinkStatusWithID(tenant string, namespace string, Sink string, id int) (pulsaradmin.SinkInstanceStatusData, error) {
+	args := m.Called(tenant, namespace, Sink, id)
+	if fn, ok := args.Get(0).(func(string, string, string, int) (pulsaradmin.SinkInstanceStatusData, error)); ok {
+		return fn(tenant, namespace, Sink, id)
+	}
+	var r0 pulsaradmin.SinkInstanceStatusData
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.SinkInstanceStatusData)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// RestartSink mocks pulsaradmin.Sinks.RestartSink.
+func (m *Sinks) RestartSink(tenant string, namespace string, Sink string) error {
+	args := m.Called(tenant, namespace, Sink)
+	if fn, ok := args.Get(0).(func(string, string, string) error); ok {
+		return fn(tenant, namespace, Sink)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// RestartSinkWithID mocks pulsaradmin.Sinks.RestartSinkWithID.
+func (m *Sinks) RestartSinkWithID(tenant string, namespace string, Sink string, id int) error {
+	args := m.Called(tenant, namespace, Sink, id)
+	if fn, ok := args.Get(0).(func(string, string, string, int) error); ok {
+		return fn(tenant, namespace, Sink, id)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// StopSink mocks pulsaradmin.Sinks.StopSink.
+func (m *Sinks) StopSink(tenant string, namespace string, Sink string) error {
+	args := m.Called(tenant, namespace, Sink)
+	if fn, ok := args.Get(0).(func(string, string, string) error); ok {
+		return fn(tenant, namespace, Sink)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// StopSinkWithID mocks pulsaradmin.Sinks.StopSinkWithID.
+func (m *Sinks) StopSinkWithID(tenant string, namespace string, Sink string, id int) error {
+	args := m.Called(tenant, namespace, Sink, id)
+	if fn, ok := args.Get(0).(func(string, string, string, int) error); ok {
+		return fn(tenant, namespace, Sink, id)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// StartSink mocks pulsaradmin.Sinks.StartSink.
+func (m *Sinks) StartSink(tenant string, namespace string, Sink string) error {
+	args := m.Called(tenant, namespace, Sink)
+	if fn, ok := args.Get(0).(func(string, string, string) error); ok {
+		return fn(tenant, namespace, Sink)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// StartSinkWithID mocks pulsaradmin.Sinks.StartSinkWithID.
+func (m *Sinks) StartSinkWithID(tenant string, namespace string, Sink string, id int) error {
+	args := m.Called(tenant, namespace, Sink, id)
+	if fn, ok := args.Get(0).(func(string, string, string, int) error); ok {
+		return fn(tenant, namespace, Sink, id)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetBuiltInSinks mocks pulsaradmin.Sinks.GetBuiltInSinks.
+func (m *Sinks) GetBuiltInSinks() ([]*pulsaradmin.ConnectorDefinition, error) {
+	args := m.Called()
+	if fn, ok := args.Get(0).(func() ([]*pulsaradmin.ConnectorDefinition, error)); ok {
+		return fn()
+	}
+	var r0 []*pulsaradmin.ConnectorDefinition
+	if v := args.Get(0); v != nil {
+		r0 = v.([]*pulsaradmin.ConnectorDefinition)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// ReloadBuiltInSinks mocks pulsaradmin.Sinks.ReloadBuiltInSinks.
+func (m *Sinks) ReloadBuiltInSinks() error {
+	args := m.Called()
+	if fn, ok := args.Get(0).(func() error); ok {
+		return fn()
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// Sources is a mock of pulsaradmin.Sources.
+type Sources struct {
+	mock.Mock
+}
+
+var _ pulsaradmin.Sources = (*Sources)(nil)
+
+// NewSources returns a new Sources mock that asserts its expectations
+// when the test completes.
+func NewSources(t TestingT) *Sources {
+	m := &Sources{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// ListSources mocks pulsaradmin.Sources.ListSources.
+func (m *Sources) ListSources(tenant string, namespace string) ([]string, error) {
+	args := m.Called(tenant, namespace)
+	if fn, ok := args.Get(0).(func(string, string) ([]string, error)); ok {
+		return fn(tenant, namespace)
+	}
+	var r0 []string
+	if v := args.Get(0); v != nil {
+		r0 = v.([]string)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetSource mocks pulsaradmin.Sources.GetSource.
+func (m *Sources) GetSource(tenant string, namespace string, source string) (pulsaradmin.SourceConfig, error) {
+	args := m.Called(tenant, namespace, source)
+	if fn, ok := args.Get(0).(func(string, string, string) (pulsaradmin.SourceConfig, error)); ok {
+		return fn(tenant, namespace, source)
+	}
+	var r0 pulsaradmin.SourceConfig
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.SourceConfig)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// CreateSource mocks pulsaradmin.Sources.CreateSource.
+func (m *Sources) CreateSource(config *pulsaradmin.SourceConfig, fileName string) error {
+	args := m.Called(config, fileName)
+	if fn, ok := args.Get(0).(func(*pulsaradmin.SourceConfig, string) error); ok {
+		return fn(config, fileName)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// CreateSourceWithReader mocks pulsaradmin.Sources.CreateSourceWithReader.
+func (m *Sources) CreateSourceWithReader(config *pulsaradmin.SourceConfig, fileName string, r io.Reader, options *pulsaradmin.UploadOptions) error {
+	args := m.Called(config, fileName, r, options)
+	if fn, ok := args.Get(0).(func(*pulsaradmin.SourceConfig, string, io.Reader, *pulsaradmin.UploadOptions) error); ok {
+		return fn(config, fileName, r, options)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// CreateSourceWithURL mocks pulsaradmin.Sources.CreateSourceWithURL.
+func (m *Sources) CreateSourceWithURL(config *pulsaradmin.SourceConfig, pkgURL string) error {
+	args := m.Called(config, pkgURL)
+	if fn, ok := args.Get(0).(func(*pulsaradmin.SourceConfig, string) error); ok {
+		return fn(config, pkgURL)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// UpdateSource mocks pulsaradmin.Sources.UpdateSource.
+func (m *Sources) UpdateSource(config *pulsaradmin.SourceConfig, fileName string, options *pulsaradmin.UpdateOptions) error {
+	args := m.Called(config, fileName, options)
+	if fn, ok := args.Get(0).(func(*pulsaradmin.SourceConfig, string, *pulsaradmin.UpdateOptions) error); ok {
+		return fn(config, fileName, options)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// UpdateSourceWithReader mocks pulsaradmin.Sources.UpdateSourceWithReader.
+func (m *Sources) UpdateSourceWithReader(config *pulsaradmin.SourceConfig, fileName string, r io.Reader, options *pulsaradmin.UpdateOptions, uploadOptions *pulsaradmin.UploadOptions) error {
+	args := m.Called(config, fileName, r, options, uploadOptions)
+	if fn, ok := args.Get(0).(func(*pulsaradmin.SourceConfig, string, io.Reader, *pulsaradmin.UpdateOptions, *pulsaradmin.UploadOptions) error); ok {
+		return fn(config, fileName, r, options, uploadOptions)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// UpdateSourceWithURL mocks pulsaradmin.Sources.UpdateSourceWithURL.
+func (m *Sources) UpdateSourceWithURL(config *pulsaradmin.SourceConfig, pkgURL string, options *pulsaradmin.UpdateOptions) error {
+	args := m.Called(config, pkgURL, options)
+	if fn, ok := args.Get(0).(func(*pulsaradmin.SourceConfig, string, *pulsaradmin.UpdateOptions) error); ok {
+		return fn(config, pkgURL, options)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// DeleteSource mocks pulsaradmin.Sources.DeleteSource.
+func (m *Sources) DeleteSource(tenant string, namespace string, source string) error {
+	args := m.Called(tenant, namespace, source)
+	if fn, ok := args.Get(0).(func(string, string, string) error); ok {
+		return fn(tenant, namespace, source)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetSourceStatus mocks pulsaradmin.Sources.GetSourceStatus.
+func (m *Sources) GetSourceStatus(tenant string, namespace string, source string) (pulsaradmin.SourceStatus, error) {
+	args := m.Called(tenant, namespace, source)
+	if fn, ok := args.Get(0).(func(string, string, string) (pulsaradmin.SourceStatus, error)); ok {
+		return fn(tenant, namespace, source)
+	}
+	var r0 pulsaradmin.SourceStatus
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.SourceStatus)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetSourceStatusWithID mocks pulsaradmin.Sources.GetSourceStatusWithID.
+func (m *Sources) GetSourceStatusWithID(tenant string, namespace string, source string, id int) (pulsaradmin.SourceInstanceStatusData, error) {
+	args := m.Called(tenant, namespace, source, id)
+	if fn, ok := args.Get(0).(func(string, string, string, int) (pulsaradmin.SourceInstanceStatusData, error)); ok {
+		return fn(tenant, namespace, source, id)
+	}
+	var r0 pulsaradmin.SourceInstanceStatusData
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.SourceInstanceStatusData)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// RestartSource mocks pulsaradmin.Sources.RestartSource.
+func (m *Sources) RestartSource(tenant string, namespace string, source string) error {
+	args := m.Called(tenant, namespace, source)
+	if fn, ok := args.Get(0).(func(string, string, string) error); ok {
+		return fn(tenant, namespace, source)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// RestartSourceWithID mocks pulsaradmin.Sources.RestartSourceWithID.
+func (m *Sources) RestartSourceWithID(tenant string, namespace string, source string, id int) error {
+	args := m.Called(tenant, namespace, source, id)
+	if fn, ok := args.Get(0).(func(string, string, string, int) error); ok {
+		return fn(tenant, namespace, source, id)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// StopSource mocks pulsaradmin.Sources.StopSource.
+func (m *Sources) StopSource(tenant string, namespace string, source string) error {
+	args := m.Called(tenant, namespace, source)
+	if fn, ok := args.Get(0).(func(string, string, string) error); ok {
+		return fn(tenant, namespace, source)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// StopSourceWithID mocks pulsaradmin.Sources.StopSourceWithID.
+func (m *Sources) StopSourceWithID(tenant string, namespace string, source string, id int) error {
+	args := m.Called(tenant, namespace, source, id)
+	if fn, ok := args.Get(0).(func(string, string, string, int) error); ok {
+		return fn(tenant, namespace, source, id)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// StartSource mocks pulsaradmin.Sources.StartSource.
+func (m *Sources) StartSource(tenant string, namespace string, source string) error {
+	args := m.Called(tenant, namespace, source)
+	if fn, ok := args.Get(0).(func(string, string, string) error); ok {
+		return fn(tenant, namespace, source)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// StartSourceWithID mocks pulsaradmin.Sources.StartSourceWithID.
+func (m *Sources) StartSourceWithID(tenant string, namespace string, source string, id int) error {
+	args := m.Called(tenant, namespace, source, id)
+	if fn, ok := args.Get(0).(func(string, string, string, int) error); ok {
+		return fn(tenant, namespace, source, id)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetBuiltInSources mocks pulsaradmin.Sources.GetBuiltInSources.
+func (m *Sources) GetBuiltInSources() ([]*pulsaradmin.ConnectorDefinition, error) {
+	args := m.Called()
+	if fn, ok := args.Get(0).(func() ([]*pulsaradmin.ConnectorDefinition, error)); ok {
+		return fn()
+	}
+	var r0 []*pulsaradmin.ConnectorDefinition
+	if v := args.Get(0); v != nil {
+		r0 = v.([]*pulsaradmin.ConnectorDefinition)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// ReloadBuiltInSources mocks pulsaradmin.Sources.ReloadBuiltInSources.
+func (m *Sources) ReloadBuiltInSources() error {
+	args := m.Called()
+	if fn, ok := args.Get(0).(func() error); ok {
+		return fn()
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// Subscriptions is a mock of pulsaradmin.Subscriptions.
+type Subscriptions struct {
+	mock.Mock
+}
+
+var _ pulsaradmin.Subscriptions = (*Subscriptions)(nil)
+
+// NewSubscriptions returns a new Subscriptions mock that asserts its expectations
+// when the test completes.
+func NewSubscriptions(t TestingT) *Subscriptions {
+	m := &Subscriptions{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// Create mocks pulsaradmin.Subscriptions.Create.
+func (m *Subscriptions) Create(a0 pulsaradmin.TopicName, a1 string, a2 pulsaradmin.MessageID) error {
+	args := m.Called(a0, a1, a2)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName, string, pulsaradmin.MessageID) error); ok {
+		return fn(a0, a1, a2)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// Delete mocks pulsaradmin.Subscriptions.Delete.
+func (m *Subscriptions) Delete(a0 pulsaradmin.TopicName, a1 string) error {
+	args := m.Called(a0, a1)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName, string) error); ok {
+		return fn(a0, a1)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// ForceDelete mocks pulsaradmin.Subscriptions.ForceDelete.
+func (m *Subscriptions) ForceDelete(a0 pulsaradmin.TopicName, a1 string) error {
+	args := m.Called(a0, a1)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName, string) error); ok {
+		return fn(a0, a1)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// List mocks pulsaradmin.Subscriptions.List.
+func (m *Subscriptions) List(a0 pulsaradmin.TopicName) ([]string, error) {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName) ([]string, error)); ok {
+		return fn(a0)
+	}
+	var r0 []string
+	if v := args.Get(0); v != nil {
+		r0 = v.([]string)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// ResetCursorToMessageID mocks pulsaradmin.Subscriptions.ResetCursorToMessageID.
+func (m *Subscriptions) ResetCursorToMessageID(a0 pulsaradmin.TopicName, a1 string, a2 pulsaradmin.MessageID) error {
+	args := m.Called(a0, a1, a2)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName, string, pulsaradmin.MessageID) error); ok {
+		return fn(a0, a1, a2)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// ResetCursorToTimestamp mocks pulsaradmin.Subscriptions.ResetCursorToTimestamp.
+func (m *Subscriptions) ResetCursorToTimestamp(a0 pulsaradmin.TopicName, a1 string, a2 int64) error {
+	args := m.Called(a0, a1, a2)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName, string, int64) error); ok {
+		return fn(a0, a1, a2)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// ClearBacklog mocks pulsaradmin.Subscriptions.ClearBacklog.
+func (m *Subscriptions) ClearBacklog(a0 pulsaradmin.TopicName, a1 string) error {
+	args := m.Called(a0, a1)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName, string) error); ok {
+		return fn(a0, a1)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// SkipMessages mocks pulsaradmin.Subscriptions.SkipMessages.
+func (m *Subscriptions) SkipMessages(a0 pulsaradmin.TopicName, a1 string, a2 int64) error {
+	args := m.Called(a0, a1, a2)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName, string, int64) error); ok {
+		return fn(a0, a1, a2)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// ExpireMessages mocks pulsaradmin.Subscriptions.ExpireMessages.
+func (m *Subscriptions) ExpireMessages(a0 pulsaradmin.TopicName, a1 string, a2 int64) error {
+	args := m.Called(a0, a1, a2)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName, string, int64) error); ok {
+		return fn(a0, a1, a2)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// ExpireAllMessages mocks pulsaradmin.Subscriptions.ExpireAllMessages.
+func (m *Subscriptions) ExpireAllMessages(a0 pulsaradmin.TopicName, a1 int64) error {
+	args := m.Called(a0, a1)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName, int64) error); ok {
+		return fn(a0, a1)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// PeekMessages mocks pulsaradmin.Subscriptions.PeekMessages.
+func (m *Subscriptions) PeekMessages(a0 pulsaradmin.TopicName, a1 string, a2 int) ([]*pulsaradmin.Message, error) {
+	args := m.Called(a0, a1, a2)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName, string, int) ([]*pulsaradmin.Message, error)); ok {
+		return fn(a0, a1, a2)
+	}
+	var r0 []*pulsaradmin.Message
+	if v := args.Get(0); v != nil {
+		r0 = v.([]*pulsaradmin.Message)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetMessageByID mocks pulsaradmin.Subscriptions.GetMessageByID.
+func (m *Subscriptions) GetMessageByID(topic pulsaradmin.TopicName, ledgerID int64, entryID int64) (*pulsaradmin.Message, error) {
+	args := m.Called(topic, ledgerID, entryID)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName, int64, int64) (*pulsaradmin.Message, error)); ok {
+		return fn(topic, ledgerID, entryID)
+	}
+	var r0 *pulsaradmin.Message
+	if v := args.Get(0); v != nil {
+		r0 = v.(*pulsaradmin.Message)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// PeekMessagesWithOptions mocks pulsaradmin.Subscriptions.PeekMessagesWithOptions.
+func (m *Subscriptions) PeekMessagesWithOptions(topic pulsaradmin.TopicName, sName string, n int, options *pulsaradmin.PeekOptions) ([]*pulsaradmin.Message, error) {
+	args := m.Called(topic, sName, n, options)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName, string, int, *pulsaradmin.PeekOptions) ([]*pulsaradmin.Message, error)); ok {
+		return fn(topic, sName, n, options)
+	}
+	var r0 []*pulsaradmin.Message
+	if v := args.Get(0); v != nil {
+		r0 = v.([]*pulsaradmin.Message)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetMessageByIDWithOptions mocks pulsaradmin.Subscriptions.GetMessageByIDWithOptions.
+func (m *Subscriptions) GetMessageByIDWithOptions(topic pulsaradmin.TopicName, ledgerID int64, entryID int64, options *pulsaradmin.PeekOptions) (*pulsaradmin.Message, error) {
+	args := m.Called(topic, ledgerID, entryID, options)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName, int64, int64, *pulsaradmin.PeekOptions) (*pulsaradmin.Message, error)); ok {
+		return fn(topic, ledgerID, entryID, options)
+	}
+	var r0 *pulsaradmin.Message
+	if v := args.Get(0); v != nil {
+		r0 = v.(*pulsaradmin.Message)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetMessagesByID mocks pulsaradmin.Subscriptions.GetMessagesByID.
+func (m *Subscriptions) GetMessagesByID(topic pulsaradmin.TopicName, ledgerID int64, entryID int64, options *pulsaradmin.PeekOptions) ([]*pulsaradmin.Message, error) {
+	args := m.Called(topic, ledgerID, entryID, options)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName, int64, int64, *pulsaradmin.PeekOptions) ([]*pulsaradmin.Message, error)); ok {
+		return fn(topic, ledgerID, entryID, options)
+	}
+	var r0 []*pulsaradmin.Message
+	if v := args.Get(0); v != nil {
+		r0 = v.([]*pulsaradmin.Message)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// Tenants is a mock of pulsaradmin.Tenants.
+type Tenants struct {
+	mock.Mock
+}
+
+var _ pulsaradmin.Tenants = (*Tenants)(nil)
+
+// NewTenants returns a new Tenants mock that asserts its expectations
+// when the test completes.
+func NewTenants(t TestingT) *Tenants {
+	m := &Tenants{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// Create mocks pulsaradmin.Tenants.Create.
+func (m *Tenants) Create(a0 pulsaradmin.TenantData) error {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TenantData) error); ok {
+		return fn(a0)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// Delete mocks pulsaradmin.Tenants.Delete.
+func (m *Tenants) Delete(a0 string) error {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(string) error); ok {
+		return fn(a0)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// Update mocks pulsaradmin.Tenants.Update.
+func (m *Tenants) Update(a0 pulsaradmin.TenantData) error {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TenantData) error); ok {
+		return fn(a0)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// List mocks pulsaradmin.Tenants.List.
+func (m *Tenants) List() ([]string, error) {
+	args := m.Called()
+	if fn, ok := args.Get(0).(func() ([]string, error)); ok {
+		return fn()
+	}
+	var r0 []string
+	if v := args.Get(0); v != nil {
+		r0 = v.([]string)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// Get mocks pulsaradmin.Tenants.Get.
+func (m *Tenants) Get(a0 string) (pulsaradmin.TenantData, error) {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(string) (pulsaradmin.TenantData, error)); ok {
+		return fn(a0)
+	}
+	var r0 pulsaradmin.TenantData
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.TenantData)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// Topics is a mock of pulsaradmin.Topics.
+type Topics struct {
+	mock.Mock
+}
+
+var _ pulsaradmin.Topics = (*Topics)(nil)
+
+// NewTopics returns a new Topics mock that asserts its expectations
+// when the test completes.
+func NewTopics(t TestingT) *Topics {
+	m := &Topics{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// Create mocks pulsaradmin.Topics.Create.
+func (m *Topics) Create(a0 pulsaradmin.TopicName, a1 int) error {
+	args := m.Called(a0, a1)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName, int) error); ok {
+		return fn(a0, a1)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// Delete mocks pulsaradmin.Topics.Delete.
+func (m *Topics) Delete(a0 pulsaradmin.TopicName, a1 bool, a2 bool) error {
+	args := m.Called(a0, a1, a2)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName, bool, bool) error); ok {
+		return fn(a0, a1, a2)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// Update mocks pulsaradmin.Topics.Update.
+func (m *Topics) Update(a0 pulsaradmin.TopicName, a1 int) error {
+	args := m.Called(a0, a1)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName, int) error); ok {
+		return fn(a0, a1)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetMetadata mocks pulsaradmin.Topics.GetMetadata.
+func (m *Topics) GetMetadata(a0 pulsaradmin.TopicName) (pulsaradmin.PartitionedTopicMetadata, error) {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName) (pulsaradmin.PartitionedTopicMetadata, error)); ok {
+		return fn(a0)
+	}
+	var r0 pulsaradmin.PartitionedTopicMetadata
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.PartitionedTopicMetadata)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// List mocks pulsaradmin.Topics.List.
+func (m *Topics) List(a0 pulsaradmin.NameSpaceName) ([]string, []string, error) {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.NameSpaceName) ([]string, []string, error)); ok {
+		return fn(a0)
+	}
+	var r0 []string
+	if v := args.Get(0); v != nil {
+		r0 = v.([]string)
+	}
+	var r1 []string
+	if v := args.Get(1); v != nil {
+		r1 = v.([]string)
+	}
+	var r2 error
+	if v := args.Get(2); v != nil {
+		r2 = v.(error)
+	}
+	return r0, r1, r2
+}
+
+// GetInternalInfo mocks pulsaradmin.Topics.GetInternalInfo.
+func (m *Topics) GetInternalInfo(a0 pulsaradmin.TopicName) (pulsaradmin.ManagedLedgerInfo, error) {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName) (pulsaradmin.ManagedLedgerInfo, error)); ok {
+		return fn(a0)
+	}
+	var r0 pulsaradmin.ManagedLedgerInfo
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.ManagedLedgerInfo)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetPermissions mocks pulsaradmin.Topics.GetPermissions.
+func (m *Topics) GetPermissions(a0 pulsaradmin.TopicName) (map[string][]pulsaradmin.AuthAction, error) {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName) (map[string][]pulsaradmin.AuthAction, error)); ok {
+		return fn(a0)
+	}
+	var r0 map[string][]pulsaradmin.AuthAction
+	if v := args.Get(0); v != nil {
+		r0 = v.(map[string][]pulsaradmin.AuthAction)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GrantPermission mocks pulsaradmin.Topics.GrantPermission.
+func (m *Topics) GrantPermission(a0 pulsaradmin.TopicName, a1 string, a2 []pulsaradmin.AuthAction) error {
+	args := m.Called(a0, a1, a2)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName, string, []pulsaradmin.AuthAction) error); ok {
+		return fn(a0, a1, a2)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// RevokePermission mocks pulsaradmin.Topics.RevokePermission.
+func (m *Topics) RevokePermission(a0 pulsaradmin.TopicName, a1 string) error {
+	args := m.Called(a0, a1)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName, string) error); ok {
+		return fn(a0, a1)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// Lookup mocks pulsaradmin.Topics.Lookup.
+func (m *Topics) Lookup(a0 pulsaradmin.TopicName) (pulsaradmin.LookupData, error) {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName) (pulsaradmin.LookupData, error)); ok {
+		return fn(a0)
+	}
+	var r0 pulsaradmin.LookupData
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.LookupData)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetBundleRange mocks pulsaradmin.Topics.GetBundleRange.
+func (m *Topics) GetBundleRange(a0 pulsaradmin.TopicName) (string, error) {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName) (string, error)); ok {
+		return fn(a0)
+	}
+	var r0 string
+	if v := args.Get(0); v != nil {
+		r0 = v.(string)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetLastMessageID mocks pulsaradmin.Topics.GetLastMessageID.
+func (m *Topics) GetLastMessageID(a0 pulsaradmin.TopicName) (pulsaradmin.MessageID, error) {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName) (pulsaradmin.MessageID, error)); ok {
+		return fn(a0)
+	}
+	var r0 pulsaradmin.MessageID
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.MessageID)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetMessageID mocks pulsaradmin.Topics.GetMessageID.
+func (m *Topics) GetMessageID(a0 pulsaradmin.TopicName, a1 int64) (pulsaradmin.MessageID, error) {
+	args := m.Called(a0, a1)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName, int64) (pulsaradmin.MessageID, error)); ok {
+		return fn(a0, a1)
+	}
+	var r0 pulsaradmin.MessageID
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.MessageID)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetStats mocks pulsaradmin.Topics.GetStats.
+func (m *Topics) GetStats(a0 pulsaradmin.TopicName) (pulsaradmin.TopicStats, error) {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName) (pulsaradmin.TopicStats, error)); ok {
+		return fn(a0)
+	}
+	var r0 pulsaradmin.TopicStats
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.TopicStats)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetInternalStats mocks pulsaradmin.Topics.GetInternalStats.
+func (m *Topics) GetInternalStats(a0 pulsaradmin.TopicName) (pulsaradmin.PersistentTopicInternalStats, error) {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName) (pulsaradmin.PersistentTopicInternalStats, error)); ok {
+		return fn(a0)
+	}
+	var r0 pulsaradmin.PersistentTopicInternalStats
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.PersistentTopicInternalStats)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetPartitionedStats mocks pulsaradmin.Topics.GetPartitionedStats.
+func (m *Topics) GetPartitionedStats(a0 pulsaradmin.TopicName, a1 bool) (pulsaradmin.PartitionedTopicStats, error) {
+	args := m.Called(a0, a1)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName, bool) (pulsaradmin.PartitionedTopicStats, error)); ok {
+		return fn(a0, a1)
+	}
+	var r0 pulsaradmin.PartitionedTopicStats
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.PartitionedTopicStats)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// Terminate mocks pulsaradmin.Topics.Terminate.
+func (m *Topics) Terminate(a0 pulsaradmin.TopicName) (pulsaradmin.MessageID, error) {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName) (pulsaradmin.MessageID, error)); ok {
+		return fn(a0)
+	}
+	var r0 pulsaradmin.MessageID
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.MessageID)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// Offload mocks pulsaradmin.Topics.Offload.
+func (m *Topics) Offload(a0 pulsaradmin.TopicName, a1 pulsaradmin.MessageID) error {
+	args := m.Called(a0, a1)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName, pulsaradmin.MessageID) error); ok {
+		return fn(a0, a1)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// OffloadStatus mocks pulsaradmin.Topics.OffloadStatus.
+func (m *Topics) OffloadStatus(a0 pulsaradmin.TopicName) (pulsaradmin.OffloadProcessStatus, error) {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName) (pulsaradmin.OffloadProcessStatus, error)); ok {
+		return fn(a0)
+	}
+	var r0 pulsaradmin.OffloadProcessStatus
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.OffloadProcessStatus)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// Unload mocks pulsaradmin.Topics.Unload.
+func (m *Topics) Unload(a0 pulsaradmin.TopicName) error {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName) error); ok {
+		return fn(a0)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// Compact mocks pulsaradmin.Topics.Compact.
+func (m *Topics) Compact(a0 pulsaradmin.TopicName) error {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName) error); ok {
+		return fn(a0)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// CompactStatus mocks pulsaradmin.Topics.CompactStatus.
+func (m *Topics) CompactStatus(a0 pulsaradmin.TopicName) (pulsaradmin.LongRunningProcessStatus, error) {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName) (pulsaradmin.LongRunningProcessStatus, error)); ok {
+		return fn(a0)
+	}
+	var r0 pulsaradmin.LongRunningProcessStatus
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.LongRunningProcessStatus)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// GetMessageTTL mocks pulsaradmin.Topics.GetMessageTTL.
+func (m *Topics) GetMessageTTL(a0 pulsaradmin.TopicName) (int, error) {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName) (int, error)); ok {
+		return fn(a0)
+	}
+	var r0 int
+	if v := args.Get(0); v != nil {
+		r0 = v.(int)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// SetMessageTTL mocks pulsaradmin.Topics.SetMessageTTL.
+func (m *Topics) SetMessageTTL(a0 pulsaradmin.TopicName, a1 int) error {
+	args := m.Called(a0, a1)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName, int) error); ok {
+		return fn(a0, a1)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// RemoveMessageTTL mocks pulsaradmin.Topics.RemoveMessageTTL.
+func (m *Topics) RemoveMessageTTL(a0 pulsaradmin.TopicName) error {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName) error); ok {
+		return fn(a0)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetMaxProducers mocks pulsaradmin.Topics.GetMaxProducers.
+func (m *Topics) GetMaxProducers(a0 pulsaradmin.TopicName) (int, error) {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName) (int, error)); ok {
+		return fn(a0)
+	}
+	var r0 int
+	if v := args.Get(0); v != nil {
+		r0 = v.(int)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// SetMaxProducers mocks pulsaradmin.Topics.SetMaxProducers.
+func (m *Topics) SetMaxProducers(a0 pulsaradmin.TopicName, a1 int) error {
+	args := m.Called(a0, a1)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName, int) error); ok {
+		return fn(a0, a1)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// RemoveMaxProducers mocks pulsaradmin.Topics.RemoveMaxProducers.
+func (m *Topics) RemoveMaxProducers(a0 pulsaradmin.TopicName) error {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName) error); ok {
+		return fn(a0)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetMaxConsumers mocks pulsaradmin.Topics.GetMaxConsumers.
+func (m *Topics) GetMaxConsumers(a0 pulsaradmin.TopicName) (int, error) {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName) (int, error)); ok {
+		return fn(a0)
+	}
+	var r0 int
+	if v := args.Get(0); v != nil {
+		r0 = v.(int)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// SetMaxConsumers mocks pulsaradmin.Topics.SetMaxConsumers.
+func (m *Topics) SetMaxConsumers(a0 pulsaradmin.TopicName, a1 int) error {
+	args := m.Called(a0, a1)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName, int) error); ok {
+		return fn(a0, a1)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// RemoveMaxConsumers mocks pulsaradmin.Topics.RemoveMaxConsumers.
+func (m *Topics) RemoveMaxConsumers(a0 pulsaradmin.TopicName) error {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName) error); ok {
+		return fn(a0)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetMaxUnackMessagesPerConsumer mocks pulsaradmin.Topics.GetMaxUnackMessagesPerConsumer.
+func (m *Topics) GetMaxUnackMessagesPerConsumer(a0 pulsaradmin.TopicName) (int, error) {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName) (int, error)); ok {
+		return fn(a0)
+	}
+	var r0 int
+	if v := args.Get(0); v != nil {
+		r0 = v.(int)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// SetMaxUnackMessagesPerConsumer mocks pulsaradmin.Topics.SetMaxUnackMessagesPerConsumer.
+func (m *Topics) SetMaxUnackMessagesPerConsumer(a0 pulsaradmin.TopicName, a1 int) error {
+	args := m.Called(a0, a1)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName, int) error); ok {
+		return fn(a0, a1)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// RemoveMaxUnackMessagesPerConsumer mocks pulsaradmin.Topics.RemoveMaxUnackMessagesPerConsumer.
+func (m *Topics) RemoveMaxUnackMessagesPerConsumer(a0 pulsaradmin.TopicName) error {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName) error); ok {
+		return fn(a0)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetMaxUnackMessagesPerSubscription mocks pulsaradmin.Topics.GetMaxUnackMessagesPerSubscription.
+func (m *Topics) GetMaxUnackMessagesPerSubscription(a0 pulsaradmin.TopicName) (int, error) {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName) (int, error)); ok {
+		return fn(a0)
+	}
+	var r0 int
+	if v := args.Get(0); v != nil {
+		r0 = v.(int)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// SetMaxUnackMessagesPerSubscription mocks pulsaradmin.Topics.SetMaxUnackMessagesPerSubscription.
+func (m *Topics) SetMaxUnackMessagesPerSubscription(a0 pulsaradmin.TopicName, a1 int) error {
+	args := m.Called(a0, a1)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName, int) error); ok {
+		return fn(a0, a1)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// RemoveMaxUnackMessagesPerSubscription mocks pulsaradmin.Topics.RemoveMaxUnackMessagesPerSubscription.
+func (m *Topics) RemoveMaxUnackMessagesPerSubscription(a0 pulsaradmin.TopicName) error {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName) error); ok {
+		return fn(a0)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetPersistence mocks pulsaradmin.Topics.GetPersistence.
+func (m *Topics) GetPersistence(a0 pulsaradmin.TopicName) (*pulsaradmin.PersistenceData, error) {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName) (*pulsaradmin.PersistenceData, error)); ok {
+		return fn(a0)
+	}
+	var r0 *pulsaradmin.PersistenceData
+	if v := args.Get(0); v != nil {
+		r0 = v.(*pulsaradmin.PersistenceData)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// SetPersistence mocks pulsaradmin.Topics.SetPersistence.
+func (m *Topics) SetPersistence(a0 pulsaradmin.TopicName, a1 pulsaradmin.PersistenceData) error {
+	args := m.Called(a0, a1)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName, pulsaradmin.PersistenceData) error); ok {
+		return fn(a0, a1)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// RemovePersistence mocks pulsaradmin.Topics.RemovePersistence.
+func (m *Topics) RemovePersistence(a0 pulsaradmin.TopicName) error {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName) error); ok {
+		return fn(a0)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetDelayedDelivery mocks pulsaradmin.Topics.GetDelayedDelivery.
+func (m *Topics) GetDelayedDelivery(a0 pulsaradmin.TopicName) (*pulsaradmin.DelayedDeliveryData, error) {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName) (*pulsaradmin.DelayedDeliveryData, error)); ok {
+		return fn(a0)
+	}
+	var r0 *pulsaradmin.DelayedDeliveryData
+	if v := args.Get(0); v != nil {
+		r0 = v.(*pulsaradmin.DelayedDeliveryData)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// SetDelayedDelivery mocks pulsaradmin.Topics.SetDelayedDelivery.
+func (m *Topics) SetDelayedDelivery(a0 pulsaradmin.TopicName, a1 pulsaradmin.DelayedDeliveryData) error {
+	args := m.Called(a0, a1)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName, pulsaradmin.DelayedDeliveryData) error); ok {
+		return fn(a0, a1)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// RemoveDelayedDelivery mocks pulsaradmin.Topics.RemoveDelayedDelivery.
+func (m *Topics) RemoveDelayedDelivery(a0 pulsaradmin.TopicName) error {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName) error); ok {
+		return fn(a0)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetDispatchRate mocks pulsaradmin.Topics.GetDispatchRate.
+func (m *Topics) GetDispatchRate(a0 pulsaradmin.TopicName) (*pulsaradmin.DispatchRateData, error) {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName) (*pulsaradmin.DispatchRateData, error)); ok {
+		return fn(a0)
+	}
+	var r0 *pulsaradmin.DispatchRateData
+	if v := args.Get(0); v != nil {
+		r0 = v.(*pulsaradmin.DispatchRateData)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// SetDispatchRate mocks pulsaradmin.Topics.SetDispatchRate.
+func (m *Topics) SetDispatchRate(a0 pulsaradmin.TopicName, a1 pulsaradmin.DispatchRateData) error {
+	args := m.Called(a0, a1)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName, pulsaradmin.DispatchRateData) error); ok {
+		return fn(a0, a1)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// RemoveDispatchRate mocks pulsaradmin.Topics.RemoveDispatchRate.
+func (m *Topics) RemoveDispatchRate(a0 pulsaradmin.TopicName) error {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName) error); ok {
+		return fn(a0)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetPublishRate mocks pulsaradmin.Topics.GetPublishRate.
+func (m *Topics) GetPublishRate(a0 pulsaradmin.TopicName) (*pulsaradmin.PublishRateData, error) {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName) (*pulsaradmin.PublishRateData, error)); ok {
+		return fn(a0)
+	}
+	var r0 *pulsaradmin.PublishRateData
+	if v := args.Get(0); v != nil {
+		r0 = v.(*pulsaradmin.PublishRateData)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// SetPublishRate mocks pulsaradmin.Topics.SetPublishRate.
+func (m *Topics) SetPublishRate(a0 pulsaradmin.TopicName, a1 pulsaradmin.PublishRateData) error {
+	args := m.Called(a0, a1)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName, pulsaradmin.PublishRateData) error); ok {
+		return fn(a0, a1)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// RemovePublishRate mocks pulsaradmin.Topics.RemovePublishRate.
+func (m *Topics) RemovePublishRate(a0 pulsaradmin.TopicName) error {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName) error); ok {
+		return fn(a0)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetDeduplicationStatus mocks pulsaradmin.Topics.GetDeduplicationStatus.
+func (m *Topics) GetDeduplicationStatus(a0 pulsaradmin.TopicName) (bool, error) {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName) (bool, error)); ok {
+		return fn(a0)
+	}
+	var r0 bool
+	if v := args.Get(0); v != nil {
+		r0 = v.(bool)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// SetDeduplicationStatus mocks pulsaradmin.Topics.SetDeduplicationStatus.
+func (m *Topics) SetDeduplicationStatus(a0 pulsaradmin.TopicName, a1 bool) error {
+	args := m.Called(a0, a1)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName, bool) error); ok {
+		return fn(a0, a1)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// RemoveDeduplicationStatus mocks pulsaradmin.Topics.RemoveDeduplicationStatus.
+func (m *Topics) RemoveDeduplicationStatus(a0 pulsaradmin.TopicName) error {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName) error); ok {
+		return fn(a0)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetRetention mocks pulsaradmin.Topics.GetRetention.
+func (m *Topics) GetRetention(a0 pulsaradmin.TopicName, a1 bool) (*pulsaradmin.RetentionPolicies, error) {
+	args := m.Called(a0, a1)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName, bool) (*pulsaradmin.RetentionPolicies, error)); ok {
+		return fn(a0, a1)
+	}
+	var r0 *pulsaradmin.RetentionPolicies
+	if v := args.Get(0); v != nil {
+		r0 = v.(*pulsaradmin.RetentionPolicies)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// RemoveRetention mocks pulsaradmin.Topics.RemoveRetention.
+func (m *Topics) RemoveRetention(a0 pulsaradmin.TopicName) error {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName) error); ok {
+		return fn(a0)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// SetRetention mocks pulsaradmin.Topics.SetRetention.
+func (m *Topics) SetRetention(a0 pulsaradmin.TopicName, a1 pulsaradmin.RetentionPolicies) error {
+	args := m.Called(a0, a1)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName, pulsaradmin.RetentionPolicies) error); ok {
+		return fn(a0, a1)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetCompactionThreshold mocks pulsaradmin.Topics.GetCompactionThreshold.
+func (m *Topics) GetCompactionThreshold(topic pulsaradmin.TopicName, applied bool) (int64, error) {
+	args := m.Called(topic, applied)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName, bool) (int64, error)); ok {
+		return fn(topic, applied)
+	}
+	var r0 int64
+	if v := args.Get(0); v != nil {
+		r0 = v.(int64)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// SetCompactionThreshold mocks pulsaradmin.Topics.SetCompactionThreshold.
+func (m *Topics) SetCompactionThreshold(topic pulsaradmin.TopicName, threshold int64) error {
+	args := m.Called(topic, threshold)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName, int64) error); ok {
+		return fn(topic, threshold)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// RemoveCompactionThreshold mocks pulsaradmin.Topics.RemoveCompactionThreshold.
+func (m *Topics) RemoveCompactionThreshold(a0 pulsaradmin.TopicName) error {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName) error); ok {
+		return fn(a0)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetBacklogQuotaMap mocks pulsaradmin.Topics.GetBacklogQuotaMap.
+func (m *Topics) GetBacklogQuotaMap(topic pulsaradmin.TopicName, applied bool) (map[pulsaradmin.BacklogQuotaType]pulsaradmin.BacklogQuota, error) {
+	args := m.Called(topic, applied)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName, bool) (map[pulsaradmin.BacklogQuotaType]pulsaradmin.BacklogQuota, error)); ok {
+		return fn(topic, applied)
+	}
+	var r0 map[pulsaradmin.BacklogQuotaType]pulsaradmin.BacklogQuota
+	if v := args.Get(0); v != nil {
+		r0 = v.(map[pulsaradmin.BacklogQuotaType]pulsaradmin.BacklogQuota)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// SetBacklogQuota mocks pulsaradmin.Topics.SetBacklogQuota.
+func (m *Topics) SetBacklogQuota(a0 pulsaradmin.TopicName, a1 pulsaradmin.BacklogQuota, a2 pulsaradmin.BacklogQuotaType) error {
+	args := m.Called(a0, a1, a2)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName, pulsaradmin.BacklogQuota, pulsaradmin.BacklogQuotaType) error); ok {
+		return fn(a0, a1, a2)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// RemoveBacklogQuota mocks pulsaradmin.Topics.RemoveBacklogQuota.
+func (m *Topics) RemoveBacklogQuota(a0 pulsaradmin.TopicName, a1 pulsaradmin.BacklogQuotaType) error {
+	args := m.Called(a0, a1)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName, pulsaradmin.BacklogQuotaType) error); ok {
+		return fn(a0, a1)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetInactiveTopicPolicies mocks pulsaradmin.Topics.GetInactiveTopicPolicies.
+func (m *Topics) GetInactiveTopicPolicies(topic pulsaradmin.TopicName, applied bool) (pulsaradmin.InactiveTopicPolicies, error) {
+	args := m.Called(topic, applied)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName, bool) (pulsaradmin.InactiveTopicPolicies, error)); ok {
+		return fn(topic, applied)
+	}
+	var r0 pulsaradmin.InactiveTopicPolicies
+	if v := args.Get(0); v != nil {
+		r0 = v.(pulsaradmin.InactiveTopicPolicies)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// RemoveInactiveTopicPolicies mocks pulsaradmin.Topics.RemoveInactiveTopicPolicies.
+func (m *Topics) RemoveInactiveTopicPolicies(a0 pulsaradmin.TopicName) error {
+	args := m.Called(a0)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName) error); ok {
+		return fn(a0)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// SetInactiveTopicPolicies mocks pulsaradmin.Topics.SetInactiveTopicPolicies.
+func (m *Topics) SetInactiveTopicPolicies(topic pulsaradmin.TopicName, data pulsaradmin.InactiveTopicPolicies) error {
+	args := m.Called(topic, data)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName, pulsaradmin.InactiveTopicPolicies) error); ok {
+		return fn(topic, data)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
+
+// GetReplicationClusters mocks pulsaradmin.Topics.GetReplicationClusters.
+func (m *Topics) GetReplicationClusters(topic pulsaradmin.TopicName) ([]string, error) {
+	args := m.Called(topic)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName) ([]string, error)); ok {
+		return fn(topic)
+	}
+	var r0 []string
+	if v := args.Get(0); v != nil {
+		r0 = v.([]string)
+	}
+	var r1 error
+	if v := args.Get(1); v != nil {
+		r1 = v.(error)
+	}
+	return r0, r1
+}
+
+// SetReplicationClusters mocks pulsaradmin.Topics.SetReplicationClusters.
+func (m *Topics) SetReplicationClusters(topic pulsaradmin.TopicName, data []string) error {
+	args := m.Called(topic, data)
+	if fn, ok := args.Get(0).(func(pulsaradmin.TopicName, []string) error); ok {
+		return fn(topic, data)
+	}
+	var r0 error
+	if v := args.Get(0); v != nil {
+		r0 = v.(error)
+	}
+	return r0
+}
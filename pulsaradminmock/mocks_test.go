@@ -0,0 +1,89 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsaradminmock
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	pulsaradmin "github.com/streamnative/pulsar-admin-go"
+	"github.com/streamnative/pulsar-admin-go/internal/mockgen"
+)
+
+func TestMocksAreUpToDate(t *testing.T) {
+	want, err := mockgen.Generate(mockgen.Config{
+		Dir:        "..",
+		ImportPath: "github.com/streamnative/pulsar-admin-go",
+		Package:    "pulsaradminmock",
+		Root:       "Client",
+	})
+	require.NoError(t, err)
+	got, err := os.ReadFile("mocks.go")
+	require.NoError(t, err)
+	assert.Equal(t, string(want), string(got), "mocks.go is stale, run go generate ./pulsaradminmock")
+}
+
+func TestClientMock(t *testing.T) {
+	topic, err := pulsaradmin.GetTopicName("persistent://public/default/orders")
+	require.NoError(t, err)
+
+	topics := NewTopics(t)
+	topics.On("Create", *topic, 4).Return(nil).Once()
+	topics.On("GetMetadata", *topic).Return(pulsaradmin.PartitionedTopicMetadata{Partitions: 4}, nil)
+	topics.On("Delete", *topic, mock.Anything, false).Return(errors.New("boom"))
+
+	admin := NewClient(t)
+	admin.On("Topics").Return(topics)
+
+	require.NoError(t, admin.Topics().Create(*topic, 4))
+	metadata, err := admin.Topics().GetMetadata(*topic)
+	require.NoError(t, err)
+	assert.Equal(t, 4, metadata.Partitions)
+	assert.EqualError(t, admin.Topics().Delete(*topic, true, false), "boom")
+
+	admin.AssertNumberOfCalls(t, "Topics", 3)
+}
+
+func TestMockReturnFunc(t *testing.T) {
+	tenants := NewTenants(t)
+	tenants.On("Get", mock.Anything).Return(func(name string) (pulsaradmin.TenantData, error) {
+		if name == "missing" {
+			return pulsaradmin.TenantData{}, errors.New("not found")
+		}
+		return pulsaradmin.TenantData{Name: name}, nil
+	})
+
+	data, err := tenants.Get("acme")
+	require.NoError(t, err)
+	assert.Equal(t, "acme", data.Name)
+
+	_, err = tenants.Get("missing")
+	assert.EqualError(t, err, "not found")
+}
+
+func TestMockNilReturns(t *testing.T) {
+	schemas := NewSchema(t)
+	schemas.On("GetSchemaInfo", "persistent://public/default/orders").Return(nil, errors.New("not found"))
+
+	info, err := schemas.GetSchemaInfo("persistent://public/default/orders")
+	assert.Nil(t, info)
+	assert.EqualError(t, err, "not found")
+}
@@ -0,0 +1,241 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsaradmintest
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// configParts maps the functions, sources and sinks resources to the name of
+// the multipart form part carrying their configuration.
+var configParts = map[string]string{
+	"functions": "functionConfig",
+	"sources":   "sourceConfig",
+	"sinks":     "sinkConfig",
+}
+
+// component is a function, source or sink.
+type component struct {
+	config map[string]interface{}
+	// running holds the state of each instance.
+	running []bool
+}
+
+type instanceStatus struct {
+	InstanceID int `json:"instanceId"`
+	Status     struct {
+		Running bool `json:"running"`
+	} `json:"status"`
+}
+
+type componentStatus struct {
+	NumInstances int              `json:"numInstances"`
+	NumRunning   int              `json:"numRunning"`
+	Instances    []instanceStatus `json:"instances"`
+}
+
+func newComponent(config map[string]interface{}) *component {
+	c := &component{config: config}
+	c.resize()
+	return c
+}
+
+// resize keeps one instance per unit of the configured parallelism.
+func (c *component) resize() {
+	n := 1
+	if parallelism, ok := c.config["parallelism"].(float64); ok && parallelism > 0 {
+		n = int(parallelism)
+	}
+	for len(c.running) < n {
+		c.running = append(c.running, true)
+	}
+	c.running = c.running[:n]
+}
+
+func (c *component) status(instances []int) componentStatus {
+	status := componentStatus{Instances: []instanceStatus{}}
+	for _, i := range instances {
+		instance := instanceStatus{InstanceID: i}
+		instance.Status.Running = c.running[i]
+		status.Instances = append(status.Instances, instance)
+		status.NumInstances++
+		if c.running[i] {
+			status.NumRunning++
+		}
+	}
+	return status
+}
+
+func (s *Server) serveComponents(w http.ResponseWriter, r *http.Request, kind string, parts []string) {
+	if len(parts) == 1 && strings.HasPrefix(parts[0], "builtin") {
+		writeJSON(w, []interface{}{})
+		return
+	}
+	if len(parts) < 2 {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	namespace := parts[0] + "/" + parts[1]
+	components := s.components[kind]
+	if len(parts) == 2 {
+		if r.Method != http.MethodGet {
+			methodNotAllowed(w)
+			return
+		}
+		if _, ok := s.namespaces[namespace]; !ok {
+			writeError(w, http.StatusNotFound, "Namespace does not exist")
+			return
+		}
+		writeJSON(w, s.componentNames(kind, namespace))
+		return
+	}
+
+	key := namespace + "/" + parts[2]
+	c, exists := components[key]
+	if len(parts) == 3 {
+		s.serveComponent(w, r, kind, key, c)
+		return
+	}
+	if !exists {
+		writeError(w, http.StatusNotFound, "%s %s doesn't exist", kind, parts[2])
+		return
+	}
+
+	instances := make([]int, len(c.running))
+	for i := range instances {
+		instances[i] = i
+	}
+	action := parts[3]
+	if len(parts) == 5 {
+		id, err := strconv.Atoi(parts[3])
+		if err != nil || id < 0 || id >= len(c.running) {
+			writeError(w, http.StatusBadRequest, "Invalid instance id %s", parts[3])
+			return
+		}
+		instances, action = []int{id}, parts[4]
+	} else if len(parts) != 4 {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+
+	switch action {
+	case "status":
+		if r.Method != http.MethodGet {
+			methodNotAllowed(w)
+			return
+		}
+		status := c.status(instances)
+		if len(parts) == 5 {
+			writeJSON(w, status.Instances[0].Status)
+			return
+		}
+		writeJSON(w, status)
+	case "start", "stop", "restart":
+		if r.Method != http.MethodPost {
+			methodNotAllowed(w)
+			return
+		}
+		for _, i := range instances {
+			c.running[i] = action != "stop"
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusNotFound, "Not Found")
+	}
+}
+
+func (s *Server) serveComponent(w http.ResponseWriter, r *http.Request, kind, key string, c *component) {
+	name := key[strings.LastIndex(key, "/")+1:]
+	switch r.Method {
+	case http.MethodGet:
+		if c == nil {
+			writeError(w, http.StatusNotFound, "%s %s doesn't exist", kind, name)
+			return
+		}
+		writeJSON(w, c.config)
+	case http.MethodPost, http.MethodPut:
+		if r.Method == http.MethodPost && c != nil {
+			writeError(w, http.StatusConflict, "%s %s already exists", kind, name)
+			return
+		}
+		if r.Method == http.MethodPut && c == nil {
+			writeError(w, http.StatusNotFound, "%s %s doesn't exist", kind, name)
+			return
+		}
+		if _, ok := s.namespaces[key[:strings.LastIndex(key, "/")]]; !ok {
+			writeError(w, http.StatusBadRequest, "Namespace does not exist")
+			return
+		}
+		config, err := readComponentConfig(r, configParts[kind])
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if c == nil {
+			s.components[kind][key] = newComponent(config)
+		} else {
+			// An update only changes the fields it sets.
+			for field, value := range config {
+				if value != nil {
+					c.config[field] = value
+				}
+			}
+			c.resize()
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodDelete:
+		if c == nil {
+			writeError(w, http.StatusNotFound, "%s %s doesn't exist", kind, name)
+			return
+		}
+		delete(s.components[kind], key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+// componentNames returns the sorted names of the components of a kind in a
+// namespace.
+func (s *Server) componentNames(kind, namespace string) []string {
+	names := []string{}
+	for key := range s.components[kind] {
+		if strings.HasPrefix(key, namespace+"/") {
+			names = append(names, strings.TrimPrefix(key, namespace+"/"))
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// readComponentConfig reads the configuration of a function, source or sink
+// from a multipart form part.
+func readComponentConfig(r *http.Request, part string) (map[string]interface{}, error) {
+	if err := r.ParseMultipartForm(maxMemory); err != nil {
+		return nil, err
+	}
+	config := make(map[string]interface{})
+	if values := r.MultipartForm.Value[part]; len(values) > 0 {
+		if err := json.Unmarshal([]byte(values[0]), &config); err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
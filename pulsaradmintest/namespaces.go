@@ -0,0 +1,181 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsaradmintest
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	pulsaradmin "github.com/streamnative/pulsar-admin-go"
+)
+
+// policyFields maps the namespace policy endpoints to the field of the
+// Policies object returned for the namespace.
+var policyFields = map[string]string{
+	"antiAffinity":                          "antiAffinityGroup",
+	"autoTopicCreation":                     "autoTopicCreationOverride",
+	"compactionThreshold":                   "compaction_threshold",
+	"deduplication":                         "deduplicationEnabled",
+	"encryptionRequired":                    "encryption_required",
+	"isAllowAutoUpdateSchema":               "is_allow_auto_update_schema",
+	"maxConsumersPerSubscription":           "max_consumers_per_subscription",
+	"maxConsumersPerTopic":                  "max_consumers_per_topic",
+	"maxProducersPerTopic":                  "max_producers_per_topic",
+	"messageTTL":                            "message_ttl_in_seconds",
+	"offloadDeletionLagMs":                  "offload_deletion_lag_ms",
+	"offloadThreshold":                      "offload_threshold",
+	"persistence":                           "persistence",
+	"replication":                           "replication_clusters",
+	"retention":                             "retention_policies",
+	"schemaAutoUpdateCompatibilityStrategy": "schema_auto_update_compatibility_strategy",
+	"schemaValidationEnforced":              "schema_validation_enforced",
+	"subscriptionAuthMode":                  "subscription_auth_mode",
+}
+
+type namespace struct {
+	policies    map[string]json.RawMessage
+	permissions map[string][]string
+}
+
+func newNamespace() *namespace {
+	return &namespace{
+		policies:    make(map[string]json.RawMessage),
+		permissions: make(map[string][]string),
+	}
+}
+
+// policiesObject returns the Policies of the namespace, with the policies set
+// through the individual endpoints applied over the defaults.
+func (ns *namespace) policiesObject() (map[string]json.RawMessage, error) {
+	defaults, err := json.Marshal(pulsaradmin.NewDefaultPolicies())
+	if err != nil {
+		return nil, err
+	}
+	var policies map[string]json.RawMessage
+	if err := json.Unmarshal(defaults, &policies); err != nil {
+		return nil, err
+	}
+	for key, field := range policyFields {
+		if v, ok := ns.policies[key]; ok {
+			policies[field] = v
+		}
+	}
+
+	auth := pulsaradmin.NewAuthPolicies()
+	for role, actions := range ns.permissions {
+		for _, action := range actions {
+			auth.NamespaceAuth[role] = append(auth.NamespaceAuth[role], pulsaradmin.AuthAction(action))
+		}
+	}
+	if policies["auth_policies"], err = json.Marshal(auth); err != nil {
+		return nil, err
+	}
+	return policies, nil
+}
+
+func (s *Server) serveNamespaces(w http.ResponseWriter, r *http.Request, parts []string) {
+	if len(parts) == 1 {
+		if r.Method != http.MethodGet {
+			methodNotAllowed(w)
+			return
+		}
+		if _, ok := s.tenants[parts[0]]; !ok {
+			writeError(w, http.StatusNotFound, "Tenant does not exist")
+			return
+		}
+		names := s.tenantNamespaces(parts[0])
+		if names == nil {
+			names = []string{}
+		}
+		writeJSON(w, names)
+		return
+	}
+	if len(parts) < 2 {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+
+	name := parts[0] + "/" + parts[1]
+	ns, exists := s.namespaces[name]
+	if len(parts) == 2 {
+		s.serveNamespace(w, r, name, ns)
+		return
+	}
+	if !exists {
+		writeError(w, http.StatusNotFound, "Namespace does not exist")
+		return
+	}
+
+	switch rest := parts[2:]; rest[0] {
+	case "topics":
+		if r.Method != http.MethodGet {
+			methodNotAllowed(w)
+			return
+		}
+		writeJSON(w, s.namespaceTopics("persistent", name, false))
+	case "permissions":
+		servePermissions(w, r, ns.permissions, rest[1:])
+	default:
+		servePolicy(w, r, ns.policies, strings.Join(rest, "/"))
+	}
+}
+
+func (s *Server) serveNamespace(w http.ResponseWriter, r *http.Request, name string, ns *namespace) {
+	switch r.Method {
+	case http.MethodGet:
+		if ns == nil {
+			writeError(w, http.StatusNotFound, "Namespace does not exist")
+			return
+		}
+		policies, err := ns.policiesObject()
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		writeJSON(w, policies)
+	case http.MethodPut:
+		if _, ok := s.tenants[strings.SplitN(name, "/", 2)[0]]; !ok {
+			writeError(w, http.StatusNotFound, "Tenant does not exist")
+			return
+		}
+		if ns != nil {
+			writeError(w, http.StatusConflict, "Namespace already exists")
+			return
+		}
+		var policies json.RawMessage
+		if !readJSON(w, r, &policies) {
+			return
+		}
+		s.namespaces[name] = newNamespace()
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodDelete:
+		if ns == nil {
+			writeError(w, http.StatusNotFound, "Namespace does not exist")
+			return
+		}
+		for topicName := range s.topics {
+			if _, topicNamespace, _ := splitTopicName(topicName); topicNamespace == name {
+				writeError(w, http.StatusConflict, "Cannot delete non empty namespace")
+				return
+			}
+		}
+		delete(s.namespaces, name)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
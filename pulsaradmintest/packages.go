@@ -0,0 +1,186 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsaradmintest
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	pulsaradmin "github.com/streamnative/pulsar-admin-go"
+)
+
+// maxMemory is the part of a multipart upload kept in memory while parsing.
+const maxMemory = 32 << 20
+
+type pkg struct {
+	metadata pulsaradmin.PackageMetadata
+	data     []byte
+}
+
+func (s *Server) servePackages(w http.ResponseWriter, r *http.Request, parts []string) {
+	if len(parts) < 3 || len(parts) > 6 {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	switch pulsaradmin.PackageType(parts[0]) {
+	case pulsaradmin.PackageTypeFunction, pulsaradmin.PackageTypeSink, pulsaradmin.PackageTypeSource:
+	default:
+		writeError(w, http.StatusBadRequest, "Invalid package type %s", parts[0])
+		return
+	}
+	namespace := parts[1] + "/" + parts[2]
+	if _, ok := s.namespaces[namespace]; !ok {
+		writeError(w, http.StatusNotFound, "Namespace does not exist")
+		return
+	}
+	prefix := parts[0] + "://" + namespace + "/"
+
+	switch len(parts) {
+	case 3:
+		// List the package names of a namespace.
+		if r.Method != http.MethodGet {
+			methodNotAllowed(w)
+			return
+		}
+		writeJSON(w, s.packageNames(prefix, func(name string) string {
+			return strings.SplitN(name, "@", 2)[0]
+		}))
+		return
+	case 4:
+		// List the versions of a package.
+		if r.Method != http.MethodGet {
+			methodNotAllowed(w)
+			return
+		}
+		versions := s.packageNames(prefix+parts[3]+"@", func(version string) string { return version })
+		if len(versions) == 0 {
+			writeError(w, http.StatusNotFound, "Package does not exist")
+			return
+		}
+		writeJSON(w, versions)
+		return
+	}
+
+	key := prefix + parts[3] + "@" + parts[4]
+	p, exists := s.packages[key]
+	if len(parts) == 6 {
+		if parts[5] != "metadata" {
+			writeError(w, http.StatusNotFound, "Not Found")
+			return
+		}
+		s.servePackageMetadata(w, r, p)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		if !exists {
+			writeError(w, http.StatusNotFound, "Package does not exist")
+			return
+		}
+		w.Header().Set("Content-Type", "application/octet-stream")
+		_, _ = w.Write(p.data)
+	case http.MethodPost:
+		if exists {
+			writeError(w, http.StatusConflict, "Package already exists")
+			return
+		}
+		p, err := readPackage(r)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		s.packages[key] = p
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodDelete:
+		if !exists {
+			writeError(w, http.StatusNotFound, "Package does not exist")
+			return
+		}
+		delete(s.packages, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+func (s *Server) servePackageMetadata(w http.ResponseWriter, r *http.Request, p *pkg) {
+	if p == nil {
+		writeError(w, http.StatusNotFound, "Package does not exist")
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, p.metadata)
+	case http.MethodPut:
+		var metadata pulsaradmin.PackageMetadata
+		if !readJSON(w, r, &metadata) {
+			return
+		}
+		metadata.CreateTime = p.metadata.CreateTime
+		metadata.ModificationTime = time.Now().UnixMilli()
+		p.metadata = metadata
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+// packageNames returns the sorted, distinct results of name applied to the
+// keys of the packages under prefix, with the prefix removed.
+func (s *Server) packageNames(prefix string, name func(string) string) []string {
+	seen := make(map[string]struct{})
+	for key := range s.packages {
+		if strings.HasPrefix(key, prefix) {
+			seen[name(strings.TrimPrefix(key, prefix))] = struct{}{}
+		}
+	}
+	return sortedKeys(seen)
+}
+
+// readPackage reads a package from the "metadata" and "file" parts of a
+// multipart upload.
+func readPackage(r *http.Request) (*pkg, error) {
+	if err := r.ParseMultipartForm(maxMemory); err != nil {
+		return nil, err
+	}
+	p := &pkg{}
+	if values := r.MultipartForm.Value["metadata"]; len(values) > 0 {
+		if err := json.Unmarshal([]byte(values[0]), &p.metadata); err != nil {
+			return nil, err
+		}
+	}
+	files := r.MultipartForm.File["file"]
+	if len(files) == 0 {
+		return nil, errors.New("missing file part")
+	}
+	f, err := files[0].Open()
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	if p.data, err = io.ReadAll(f); err != nil {
+		return nil, err
+	}
+	now := time.Now().UnixMilli()
+	p.metadata.CreateTime = now
+	p.metadata.ModificationTime = now
+	return p, nil
+}
@@ -0,0 +1,332 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsaradmintest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Mode selects whether a Recorder records or replays admin calls.
+type Mode int
+
+const (
+	// ModeReplay answers the calls with the recorded responses, without any
+	// network access.
+	ModeReplay Mode = iota
+	// ModeRecord sends the calls and records them.
+	ModeRecord
+)
+
+// EnvRecord is the environment variable that switches ModeFromEnv to
+// recording when set to a non-empty value.
+const EnvRecord = "PULSARADMIN_RECORD"
+
+// Redacted replaces the value of the redacted headers in the fixtures.
+const Redacted = "REDACTED"
+
+// DefaultRedactedHeaders are the headers whose values are never written to a
+// fixture.
+var DefaultRedactedHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+// ModeFromEnv returns ModeRecord when the PULSARADMIN_RECORD environment
+// variable is set, and ModeReplay otherwise.
+func ModeFromEnv() Mode {
+	if os.Getenv(EnvRecord) != "" {
+		return ModeRecord
+	}
+	return ModeReplay
+}
+
+// Recorder records the admin calls made by a client into a fixture file and
+// replays them later. Install it with ClientConfig.WrapTransport:
+//
+//	rec, err := pulsaradmintest.NewRecorder("testdata/stats.json", pulsaradmintest.ModeFromEnv())
+//	defer rec.Close()
+//
+//	admin, err := pulsaradmin.NewClient(pulsaradmin.ClientConfig{
+//		WebServiceURL: "http://localhost:8080",
+//		WrapTransport: rec.Wrap,
+//	})
+//
+// A replayed call is answered by the first recorded interaction, not used yet,
+// with the same method, path, query and body; the host is ignored. Each
+// recorded interaction answers a single call, and a call without one fails.
+//
+// The values of the RedactedHeaders of both the requests and the responses are
+// replaced in the fixture, so that the credentials of the calls, such as those
+// added by a hook or a wrapping transport, are never written to it.
+type Recorder struct {
+	mode Mode
+	path string
+
+	// RedactedHeaders are the headers whose values are replaced by Redacted
+	// in the fixture. Default is DefaultRedactedHeaders.
+	RedactedHeaders []string
+
+	mu           sync.Mutex
+	interactions []*Interaction
+	used         []bool
+}
+
+// Interaction is a recorded request and its response.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a request stored in a fixture.
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	Path   string      `json:"path"`
+	Query  string      `json:"query,omitempty"`
+	Header http.Header `json:"header,omitempty"`
+	Body   Body        `json:"body,omitempty"`
+}
+
+// RecordedResponse is a response stored in a fixture.
+type RecordedResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       Body        `json:"body,omitempty"`
+}
+
+// Body is a request or response body. It is stored as a string when it is
+// valid UTF-8, and base64 encoded otherwise.
+type Body []byte
+
+type encodedBody struct {
+	Base64 string `json:"base64"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b Body) MarshalJSON() ([]byte, error) {
+	if utf8.Valid(b) {
+		return json.Marshal(string(b))
+	}
+	return json.Marshal(encodedBody{base64.StdEncoding.EncodeToString(b)})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *Body) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*b = Body(s)
+		return nil
+	}
+	var encoded encodedBody
+	if err := json.Unmarshal(data, &encoded); err != nil {
+		return err
+	}
+	decoded, err := base64.StdEncoding.DecodeString(encoded.Base64)
+	*b = decoded
+	return err
+}
+
+type fixture struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// NewRecorder returns a Recorder using the fixture file at path. In replay
+// mode the fixture is loaded and must exist; in record mode it is written by
+// Close.
+func NewRecorder(path string, mode Mode) (*Recorder, error) {
+	r := &Recorder{mode: mode, path: path, RedactedHeaders: DefaultRedactedHeaders}
+	if mode == ModeRecord {
+		return r, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read fixture: %w", err)
+	}
+	var f fixture
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("parse fixture %s: %w", path, err)
+	}
+	r.interactions = f.Interactions
+	r.used = make([]bool, len(f.Interactions))
+	return r, nil
+}
+
+// Mode returns the mode of the recorder.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Wrap returns the transport of the recorder. In record mode it sends the
+// calls through next; in replay mode next is not used.
+func (r *Recorder) Wrap(next http.RoundTripper) http.RoundTripper {
+	return &recorderTransport{recorder: r, next: next}
+}
+
+// Close writes the fixture in record mode. It does nothing in replay mode.
+func (r *Recorder) Close() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	data, err := json.MarshalIndent(fixture{Interactions: r.interactions}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(r.path, append(data, '\n'), 0o644)
+}
+
+type recorderTransport struct {
+	recorder *Recorder
+	next     http.RoundTripper
+}
+
+func (t *recorderTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+	if t.recorder.mode == ModeRecord {
+		out := req.Clone(req.Context())
+		if body != nil {
+			out.Body = io.NopCloser(bytes.NewReader(body))
+		}
+		return t.recorder.record(t.next, out, body)
+	}
+	return t.recorder.replay(req, body)
+}
+
+func (r *Recorder) record(next http.RoundTripper, req *http.Request, body []byte) (*http.Response, error) {
+	resp, err := next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.interactions = append(r.interactions, &Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			Path:   req.URL.EscapedPath(),
+			Query:  req.URL.Query().Encode(),
+			Header: r.redact(req.Header),
+			Body:   body,
+		},
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     r.redact(resp.Header),
+			Body:       respBody,
+		},
+	})
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, body []byte) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.interactions {
+		if r.used[i] || !matches(&interaction.Request, req, body) {
+			continue
+		}
+		r.used[i] = true
+		recorded := interaction.Response
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+			StatusCode:    recorded.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        recorded.Header.Clone(),
+			Body:          io.NopCloser(bytes.NewReader(recorded.Body)),
+			ContentLength: int64(len(recorded.Body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("pulsaradmintest: no recorded interaction for %s %s in %s",
+		req.Method, req.URL.RequestURI(), r.path)
+}
+
+// redact returns a copy of h with the values of the redacted headers
+// replaced.
+func (r *Recorder) redact(h http.Header) http.Header {
+	h = h.Clone()
+	for _, name := range r.RedactedHeaders {
+		if _, ok := h[http.CanonicalHeaderKey(name)]; ok {
+			h.Set(name, Redacted)
+		}
+	}
+	return h
+}
+
+// readBody reads and closes the body of req.
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	defer req.Body.Close()
+	return io.ReadAll(req.Body)
+}
+
+// matches reports whether req is the recorded request. The boundaries of
+// multipart bodies are random, so they are ignored, and JSON bodies are
+// compared by value.
+func matches(recorded *RecordedRequest, req *http.Request, body []byte) bool {
+	if recorded.Method != req.Method || recorded.Path != req.URL.EscapedPath() ||
+		recorded.Query != req.URL.Query().Encode() {
+		return false
+	}
+	want, got := []byte(recorded.Body), body
+	if boundary := multipartBoundary(recorded.Header); boundary != "" {
+		want = bytes.ReplaceAll(want, []byte(boundary), nil)
+	}
+	if boundary := multipartBoundary(req.Header); boundary != "" {
+		got = bytes.ReplaceAll(got, []byte(boundary), nil)
+	}
+	if bytes.Equal(want, got) {
+		return true
+	}
+	var wantJSON, gotJSON interface{}
+	return json.Unmarshal(want, &wantJSON) == nil && json.Unmarshal(got, &gotJSON) == nil &&
+		reflect.DeepEqual(wantJSON, gotJSON)
+}
+
+func multipartBoundary(h http.Header) string {
+	mediaType, params, err := mime.ParseMediaType(h.Get("Content-Type"))
+	if err != nil || !strings.HasPrefix(mediaType, "multipart/") {
+		return ""
+	}
+	return params["boundary"]
+}
@@ -0,0 +1,120 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsaradmintest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pulsaradmin "github.com/streamnative/pulsar-admin-go"
+)
+
+// exercise makes calls whose results must be the same when recorded and
+// replayed.
+func exercise(t *testing.T, admin pulsaradmin.Client, jar string) {
+	t.Helper()
+	topic, err := pulsaradmin.GetTopicName("persistent://public/default/orders")
+	require.NoError(t, err)
+
+	require.NoError(t, admin.Topics().Create(*topic, 2))
+	require.NoError(t, admin.Subscriptions().Create(*topic, "sub", pulsaradmin.Earliest))
+	stats, err := admin.Topics().GetPartitionedStats(*topic, false)
+	require.NoError(t, err)
+	assert.Equal(t, 2, stats.Metadata.Partitions)
+	assert.Contains(t, stats.Subscriptions, "sub")
+
+	const url = "function://public/default/fn@v1"
+	require.NoError(t, admin.Packages().Upload(url, jar, "fn", "dev", nil))
+	out := filepath.Join(t.TempDir(), "fn.jar")
+	require.NoError(t, admin.Packages().Download(url, out))
+	data, err := os.ReadFile(out)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0xca, 0xfe, 0xba, 0xbe}, data)
+
+	_, err = admin.Tenants().Get("missing")
+	assert.True(t, pulsaradmin.IsNotFound(err))
+}
+
+func TestRecorderReplaysRecordedCalls(t *testing.T) {
+	dir := t.TempDir()
+	fixture := filepath.Join(dir, "testdata", "calls.json")
+	jar := filepath.Join(dir, "fn.jar")
+	require.NoError(t, os.WriteFile(jar, []byte{0xca, 0xfe, 0xba, 0xbe}, 0o600))
+
+	srv := NewServer()
+	rec, err := NewRecorder(fixture, ModeRecord)
+	require.NoError(t, err)
+	admin, err := pulsaradmin.NewClient(pulsaradmin.ClientConfig{WebServiceURL: srv.URL, WrapTransport: rec.Wrap})
+	require.NoError(t, err)
+	exercise(t, admin, jar)
+	require.NoError(t, rec.Close())
+	srv.Close()
+
+	rec, err = NewRecorder(fixture, ModeReplay)
+	require.NoError(t, err)
+	admin, err = pulsaradmin.NewClient(pulsaradmin.ClientConfig{
+		WebServiceURL: "http://127.0.0.1:1",
+		WrapTransport: rec.Wrap,
+	})
+	require.NoError(t, err)
+	exercise(t, admin, jar)
+
+	_, err = admin.Tenants().List()
+	assert.ErrorContains(t, err, "no recorded interaction for GET /admin/v2/tenants")
+}
+
+func TestRecorderRedactsHeaders(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "secret-session"})
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte("[]"))
+	}))
+	defer srv.Close()
+
+	fixture := filepath.Join(t.TempDir(), "calls.json")
+	rec, err := NewRecorder(fixture, ModeRecord)
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL+"/admin/v2/tenants", nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer secret-token")
+	req.Header.Set("Cookie", "session=secret-cookie")
+	resp, err := rec.Wrap(http.DefaultTransport).RoundTrip(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.NoError(t, rec.Close())
+
+	data, err := os.ReadFile(fixture)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "secret-token")
+	assert.NotContains(t, string(data), "secret-cookie")
+	assert.NotContains(t, string(data), "secret-session")
+	assert.Contains(t, string(data), Redacted)
+	assert.Contains(t, string(data), "application/json")
+	assert.Equal(t, "Bearer secret-token", req.Header.Get("Authorization"))
+	assert.Equal(t, "session=secret-session", resp.Header.Get("Set-Cookie"))
+}
+
+func TestNewRecorderRequiresFixtureToReplay(t *testing.T) {
+	_, err := NewRecorder(filepath.Join(t.TempDir(), "missing.json"), ModeReplay)
+	assert.Error(t, err)
+}
@@ -0,0 +1,203 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsaradmintest
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strconv"
+	"time"
+
+	pulsaradmin "github.com/streamnative/pulsar-admin-go"
+)
+
+type schemaVersion struct {
+	payload   pulsaradmin.PostSchemaPayload
+	timestamp int64
+}
+
+func (v schemaVersion) response(version int) pulsaradmin.GetSchemaResponse {
+	return pulsaradmin.GetSchemaResponse{
+		Version:    int64(version),
+		Type:       v.payload.SchemaType,
+		Timestamp:  v.timestamp,
+		Data:       v.payload.Schema,
+		Properties: v.payload.Properties,
+	}
+}
+
+func (s *Server) serveSchemas(w http.ResponseWriter, r *http.Request, parts []string) {
+	if len(parts) < 4 || len(parts) > 5 || (len(parts) == 5 && parts[3] != "schema") {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	namespace := parts[0] + "/" + parts[1]
+	if _, ok := s.namespaces[namespace]; !ok {
+		writeError(w, http.StatusNotFound, "Namespace does not exist")
+		return
+	}
+	key := namespace + "/" + parts[2]
+	versions := s.schemas[key]
+
+	switch parts[3] {
+	case "schema":
+	case "schemas":
+		if r.Method != http.MethodGet {
+			methodNotAllowed(w)
+			return
+		}
+		responses := make([]pulsaradmin.GetSchemaResponse, 0, len(versions))
+		for i, v := range versions {
+			responses = append(responses, v.response(i))
+		}
+		writeJSON(w, pulsaradmin.GetAllVersionsSchemaResponse{GetSchemaResponses: responses})
+		return
+	case "version":
+		var payload pulsaradmin.PostSchemaPayload
+		if r.Method != http.MethodPost {
+			methodNotAllowed(w)
+			return
+		}
+		if !readJSON(w, r, &payload) {
+			return
+		}
+		for i, v := range versions {
+			if reflect.DeepEqual(v.payload, payload) {
+				writeJSON(w, pulsaradmin.LongSchemaVersion{Version: int64(i)})
+				return
+			}
+		}
+		writeError(w, http.StatusNotFound, "Not found schema version")
+		return
+	case "compatibility":
+		var payload pulsaradmin.PostSchemaPayload
+		if r.Method != http.MethodPost {
+			methodNotAllowed(w)
+			return
+		}
+		if !readJSON(w, r, &payload) {
+			return
+		}
+		strategy := pulsaradmin.Full
+		if v, ok := s.namespaces[namespace].policies["schemaAutoUpdateCompatibilityStrategy"]; ok {
+			if err := json.Unmarshal(v, &strategy); err != nil {
+				writeError(w, http.StatusInternalServerError, "Invalid compatibility strategy: %v", err)
+				return
+			}
+		}
+		writeJSON(w, pulsaradmin.IsCompatibilityResponse{
+			IsCompatibility:             compatible(versions, payload, strategy),
+			SchemaCompatibilityStrategy: brokerStrategies[strategy],
+		})
+		return
+	default:
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+
+	if len(parts) == 5 {
+		if r.Method != http.MethodGet {
+			methodNotAllowed(w)
+			return
+		}
+		version, err := strconv.Atoi(parts[4])
+		if err != nil || version < 0 || version >= len(versions) {
+			writeError(w, http.StatusNotFound, "Not found schema of version %s", parts[4])
+			return
+		}
+		writeJSON(w, versions[version].response(version))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		if len(versions) == 0 {
+			writeError(w, http.StatusNotFound, "Schema not found")
+			return
+		}
+		latest := len(versions) - 1
+		writeJSON(w, versions[latest].response(latest))
+	case http.MethodPost:
+		var payload pulsaradmin.PostSchemaPayload
+		if !readJSON(w, r, &payload) {
+			return
+		}
+		// Uploading an existing schema again does not create a new version.
+		for i, v := range versions {
+			if reflect.DeepEqual(v.payload, payload) {
+				writeJSON(w, map[string]int{"version": i})
+				return
+			}
+		}
+		s.schemas[key] = append(versions, schemaVersion{
+			payload:   payload,
+			timestamp: time.Now().UnixMilli(),
+		})
+		writeJSON(w, map[string]int{"version": len(versions)})
+	case http.MethodDelete:
+		if len(versions) == 0 {
+			writeError(w, http.StatusNotFound, "Schema not found")
+			return
+		}
+		delete(s.schemas, key)
+		writeJSON(w, map[string]int{"version": len(versions)})
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+// brokerStrategies are the names of the compatibility strategies returned by
+// the broker.
+var brokerStrategies = map[pulsaradmin.SchemaCompatibilityStrategy]string{
+	pulsaradmin.AutoUpdateDisabled: "ALWAYS_INCOMPATIBLE",
+	pulsaradmin.AlwaysCompatible:   "ALWAYS_COMPATIBLE",
+	pulsaradmin.Backward:           "BACKWARD",
+	pulsaradmin.Forward:            "FORWARD",
+	pulsaradmin.Full:               "FULL",
+	pulsaradmin.BackwardTransitive: "BACKWARD_TRANSITIVE",
+	pulsaradmin.ForwardTransitive:  "FORWARD_TRANSITIVE",
+	pulsaradmin.FullTransitive:     "FULL_TRANSITIVE",
+}
+
+// compatible checks a schema against the existing versions with the
+// compatibility strategy of the namespace, FULL by default as on the broker.
+// The schemas the checker does not support only need to keep their type.
+func compatible(versions []schemaVersion, payload pulsaradmin.PostSchemaPayload,
+	strategy pulsaradmin.SchemaCompatibilityStrategy,
+) bool {
+	if len(versions) == 0 {
+		return true
+	}
+	existing := make([]pulsaradmin.SchemaInfo, 0, len(versions))
+	for _, v := range versions {
+		existing = append(existing, pulsaradmin.SchemaInfo{
+			Type:       v.payload.SchemaType,
+			Schema:     []byte(v.payload.Schema),
+			Properties: v.payload.Properties,
+		})
+	}
+	schema := pulsaradmin.SchemaInfo{
+		Type:       payload.SchemaType,
+		Schema:     []byte(payload.Schema),
+		Properties: payload.Properties,
+	}
+	result, err := pulsaradmin.CheckSchemaCompatibility(existing, schema, strategy)
+	if err != nil {
+		return existing[len(existing)-1].Type == schema.Type
+	}
+	return result.Compatible
+}
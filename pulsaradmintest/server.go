@@ -0,0 +1,258 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package pulsaradmintest provides an in-process fake of the Pulsar admin REST
+// API for tests.
+//
+// The fake keeps clusters, tenants, namespaces, topics, subscriptions,
+// policies, schemas, packages, functions, sources and sinks in memory and
+// answers with the status codes a broker would use, so code written against
+// pulsaradmin.Client can be tested without a running Pulsar:
+//
+//	srv := pulsaradmintest.NewServer()
+//	defer srv.Close()
+//
+//	admin, err := pulsaradmin.NewClient(pulsaradmin.ClientConfig{
+//		WebServiceURL: srv.URL,
+//	})
+//
+// A new server starts out like a standalone broker, with the "standalone"
+// cluster, the "public" tenant and the "public/default" namespace. Only the
+// v2 and v3 REST paths are served.
+//
+// A Recorder records the calls made to a real cluster into fixture files and
+// replays them, for tests that need real responses without network access.
+package pulsaradmintest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+)
+
+const (
+	// DefaultCluster is the cluster a new Server starts with.
+	DefaultCluster = "standalone"
+	// DefaultTenant is the tenant a new Server starts with.
+	DefaultTenant = "public"
+	// DefaultNamespace is the namespace a new Server starts with.
+	DefaultNamespace = "public/default"
+
+	// BrokerVersion is the version reported by the brokers/version endpoint.
+	BrokerVersion = "2.11.0"
+)
+
+// Server is a fake Pulsar admin REST API served by an httptest.Server. Pass
+// its URL as the WebServiceURL of a pulsaradmin.ClientConfig.
+type Server struct {
+	*httptest.Server
+
+	mu         sync.Mutex
+	clusters   map[string]json.RawMessage
+	tenants    map[string]*tenant
+	namespaces map[string]*namespace
+	topics     map[string]*topic
+	schemas    map[string][]schemaVersion
+	packages   map[string]*pkg
+	components map[string]map[string]*component
+}
+
+// NewServer starts and returns a new Server. The caller should call Close
+// when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		clusters:   map[string]json.RawMessage{DefaultCluster: json.RawMessage(`{}`)},
+		tenants:    map[string]*tenant{DefaultTenant: {AllowedClusters: []string{DefaultCluster}}},
+		namespaces: map[string]*namespace{DefaultNamespace: newNamespace()},
+		topics:     make(map[string]*topic),
+		schemas:    make(map[string][]schemaVersion),
+		packages:   make(map[string]*pkg),
+		components: map[string]map[string]*component{
+			"functions": make(map[string]*component),
+			"sources":   make(map[string]*component),
+			"sinks":     make(map[string]*component),
+		},
+	}
+	s.Server = httptest.NewServer(s)
+	return s
+}
+
+// ServeHTTP routes an admin REST request to the emulated resource.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	segs := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(segs) < 3 || segs[0] != "admin" || (segs[1] != "v2" && segs[1] != "v3") {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	resource, parts := segs[2], segs[3:]
+	switch resource {
+	case "brokers":
+		s.serveBrokers(w, r, parts)
+	case "clusters":
+		s.serveClusters(w, r, parts)
+	case "tenants":
+		s.serveTenants(w, r, parts)
+	case "namespaces":
+		s.serveNamespaces(w, r, parts)
+	case "persistent", "non-persistent":
+		s.serveTopics(w, r, resource, parts)
+	case "schemas":
+		s.serveSchemas(w, r, parts)
+	case "packages":
+		s.servePackages(w, r, parts)
+	case "functions", "sources", "sinks":
+		s.serveComponents(w, r, resource, parts)
+	default:
+		writeError(w, http.StatusNotFound, "Not Found")
+	}
+}
+
+func (s *Server) serveBrokers(w http.ResponseWriter, r *http.Request, parts []string) {
+	if r.Method != http.MethodGet || len(parts) != 1 {
+		methodNotAllowed(w)
+		return
+	}
+	switch parts[0] {
+	case "health":
+		writeText(w, "ok")
+	case "version":
+		writeText(w, BrokerVersion)
+	default:
+		writeError(w, http.StatusNotFound, "Not Found")
+	}
+}
+
+// writeJSON writes v as a JSON response with status 200.
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data)
+}
+
+func writeText(w http.ResponseWriter, s string) {
+	w.Header().Set("Content-Type", "text/plain")
+	_, _ = io.WriteString(w, s)
+}
+
+// writeError writes an error response in the format used by the broker, with
+// the reason in a JSON object.
+func writeError(w http.ResponseWriter, code int, format string, args ...interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(struct {
+		Reason string `json:"reason"`
+	}{fmt.Sprintf(format, args...)})
+}
+
+func methodNotAllowed(w http.ResponseWriter) {
+	writeError(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+}
+
+// readJSON decodes the request body into v. It reports false after writing
+// a 400 response when the body is not valid JSON.
+func readJSON(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil && err != io.EOF {
+		writeError(w, http.StatusBadRequest, "Invalid request body: %v", err)
+		return false
+	}
+	return true
+}
+
+// readPolicy returns the raw value set by a policy update. Most policies are
+// sent as a JSON body, a few as a query parameter.
+func readPolicy(r *http.Request) (json.RawMessage, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if len(strings.TrimSpace(string(body))) > 0 {
+		if !json.Valid(body) {
+			return nil, fmt.Errorf("invalid JSON body")
+		}
+		return body, nil
+	}
+	for _, values := range r.URL.Query() {
+		if len(values) == 0 {
+			continue
+		}
+		if json.Valid([]byte(values[0])) {
+			return json.RawMessage(values[0]), nil
+		}
+		return json.Marshal(values[0])
+	}
+	return nil, nil
+}
+
+// servePolicy emulates a policy stored verbatim under key: GET returns the
+// stored value or an empty response when it was never set, POST and PUT
+// replace it and DELETE removes it.
+func servePolicy(w http.ResponseWriter, r *http.Request, policies map[string]json.RawMessage, key string) {
+	switch r.Method {
+	case http.MethodGet:
+		if v, ok := policies[key]; ok {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(v)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodPost, http.MethodPut:
+		v, err := readPolicy(r)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "Invalid request body: %v", err)
+			return
+		}
+		if v != nil {
+			policies[key] = v
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodDelete:
+		delete(policies, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+// servePermissions emulates the permissions of a namespace or topic.
+func servePermissions(w http.ResponseWriter, r *http.Request, permissions map[string][]string, parts []string) {
+	switch {
+	case len(parts) == 0 && r.Method == http.MethodGet:
+		writeJSON(w, permissions)
+	case len(parts) == 1 && (r.Method == http.MethodPost || r.Method == http.MethodPut):
+		var actions []string
+		if !readJSON(w, r, &actions) {
+			return
+		}
+		permissions[parts[0]] = actions
+		w.WriteHeader(http.StatusNoContent)
+	case len(parts) == 1 && r.Method == http.MethodDelete:
+		delete(permissions, parts[0])
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
@@ -0,0 +1,497 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsaradmintest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pulsaradmin "github.com/streamnative/pulsar-admin-go"
+)
+
+func newClient(t *testing.T) pulsaradmin.Client {
+	t.Helper()
+	srv := NewServer()
+	t.Cleanup(srv.Close)
+
+	admin, err := pulsaradmin.NewClient(pulsaradmin.ClientConfig{WebServiceURL: srv.URL})
+	require.NoError(t, err)
+	return admin
+}
+
+func TestServerTenantsAndNamespaces(t *testing.T) {
+	admin := newClient(t)
+
+	tenants, err := admin.Tenants().List()
+	require.NoError(t, err)
+	assert.Equal(t, []string{DefaultTenant}, tenants)
+
+	tenant := pulsaradmin.TenantData{Name: "acme", AllowedClusters: []string{DefaultCluster}}
+	require.NoError(t, admin.Tenants().Create(tenant))
+	assert.True(t, pulsaradmin.IsConflict(admin.Tenants().Create(tenant)))
+
+	err = admin.Tenants().Create(pulsaradmin.TenantData{Name: "other", AllowedClusters: []string{"missing"}})
+	assert.True(t, pulsaradmin.IsPreconditionFailed(err))
+
+	_, err = admin.Tenants().Get("missing")
+	assert.True(t, pulsaradmin.IsNotFound(err))
+
+	require.NoError(t, admin.Namespaces().CreateNamespace("acme/orders"))
+	assert.True(t, pulsaradmin.IsConflict(admin.Namespaces().CreateNamespace("acme/orders")))
+	assert.True(t, pulsaradmin.IsNotFound(admin.Namespaces().CreateNamespace("missing/orders")))
+
+	namespaces, err := admin.Namespaces().GetNamespaces("acme")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"acme/orders"}, namespaces)
+
+	assert.True(t, pulsaradmin.IsConflict(admin.Tenants().Delete("acme")))
+	require.NoError(t, admin.Namespaces().DeleteNamespace("acme/orders"))
+	require.NoError(t, admin.Tenants().Delete("acme"))
+	assert.True(t, pulsaradmin.IsNotFound(admin.Tenants().Delete("acme")))
+}
+
+func TestServerNamespacePolicies(t *testing.T) {
+	admin := newClient(t)
+
+	retention := pulsaradmin.NewRetentionPolicies(60, 1024)
+	require.NoError(t, admin.Namespaces().SetRetention(DefaultNamespace, retention))
+	got, err := admin.Namespaces().GetRetention(DefaultNamespace)
+	require.NoError(t, err)
+	assert.Equal(t, retention, *got)
+
+	ns, err := pulsaradmin.GetNamespaceName(DefaultNamespace)
+	require.NoError(t, err)
+	require.NoError(t, admin.Namespaces().GrantNamespacePermission(*ns, "app",
+		[]pulsaradmin.AuthAction{"produce", "consume"}))
+
+	policies, err := admin.Namespaces().GetPolicies(DefaultNamespace)
+	require.NoError(t, err)
+	assert.Equal(t, &retention, policies.RetentionPolicies)
+	assert.Equal(t, []pulsaradmin.AuthAction{"produce", "consume"}, policies.AuthPolicies.NamespaceAuth["app"])
+
+	_, err = admin.Namespaces().GetRetention("public/missing")
+	assert.True(t, pulsaradmin.IsNotFound(err))
+}
+
+func TestServerTopicsAndSubscriptions(t *testing.T) {
+	admin := newClient(t)
+
+	topic, err := pulsaradmin.GetTopicName("persistent://public/default/orders")
+	require.NoError(t, err)
+	ns, err := pulsaradmin.GetNamespaceName(DefaultNamespace)
+	require.NoError(t, err)
+	require.NoError(t, admin.Topics().Create(*topic, 2))
+	assert.True(t, pulsaradmin.IsConflict(admin.Topics().Create(*topic, 0)))
+
+	partitioned, nonPartitioned, err := admin.Topics().List(*ns)
+	require.NoError(t, err)
+	assert.Equal(t, []string{topic.String()}, partitioned)
+	assert.Equal(t, []string{topic.String() + "-partition-0", topic.String() + "-partition-1"}, nonPartitioned)
+
+	require.NoError(t, admin.Topics().Update(*topic, 4))
+	metadata, err := admin.Topics().GetMetadata(*topic)
+	require.NoError(t, err)
+	assert.Equal(t, 4, metadata.Partitions)
+
+	require.NoError(t, admin.Subscriptions().Create(*topic, "sub", pulsaradmin.Latest))
+	assert.True(t, pulsaradmin.IsConflict(admin.Subscriptions().Create(*topic, "sub", pulsaradmin.Latest)))
+	subs, err := admin.Subscriptions().List(*topic)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"sub"}, subs)
+	require.NoError(t, admin.Subscriptions().ClearBacklog(*topic, "sub"))
+	assert.True(t, pulsaradmin.IsNotFound(admin.Subscriptions().Delete(*topic, "missing")))
+
+	require.NoError(t, admin.Topics().SetMessageTTL(*topic, 30))
+	ttl, err := admin.Topics().GetMessageTTL(*topic)
+	require.NoError(t, err)
+	assert.Equal(t, 30, ttl)
+
+	assert.True(t, pulsaradmin.IsPreconditionFailed(admin.Topics().Delete(*topic, false, false)))
+	require.NoError(t, admin.Topics().Delete(*topic, true, false))
+
+	_, err = admin.Topics().GetStats(*topic)
+	assert.True(t, pulsaradmin.IsNotFound(err))
+}
+
+func TestServerSchemas(t *testing.T) {
+	admin := newClient(t)
+	const topic = "persistent://public/default/orders"
+
+	_, err := admin.Schemas().GetSchemaInfo(topic)
+	assert.True(t, pulsaradmin.IsNotFound(err))
+
+	v0 := pulsaradmin.PostSchemaPayload{SchemaType: "JSON", Schema: `{"type":"record","name":"A","fields":[]}`}
+	v1 := pulsaradmin.PostSchemaPayload{SchemaType: "JSON", Schema: `{"type":"record","name":"B","fields":[]}`}
+	require.NoError(t, admin.Schemas().CreateSchemaByPayload(topic, v0))
+	require.NoError(t, admin.Schemas().CreateSchemaByPayload(topic, v0))
+	require.NoError(t, admin.Schemas().CreateSchemaByPayload(topic, v1))
+
+	latest, err := admin.Schemas().GetSchemaInfoWithVersion(topic)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), latest.Version)
+	assert.Equal(t, v1.Schema, string(latest.SchemaInfo.Schema))
+
+	first, err := admin.Schemas().GetSchemaInfoByVersion(topic, 0)
+	require.NoError(t, err)
+	assert.Equal(t, v0.Schema, string(first.Schema))
+
+	require.NoError(t, admin.Schemas().DeleteSchema(topic))
+	assert.True(t, pulsaradmin.IsNotFound(admin.Schemas().DeleteSchema(topic)))
+}
+
+func TestServerPackages(t *testing.T) {
+	admin := newClient(t)
+	const url = "function://public/default/fn@v1"
+
+	dir := t.TempDir()
+	src := filepath.Join(dir, "fn.jar")
+	require.NoError(t, os.WriteFile(src, []byte("package"), 0o600))
+
+	require.NoError(t, admin.Packages().Upload(url, src, "fn", "dev", map[string]string{"k": "v"}))
+	assert.True(t, pulsaradmin.IsConflict(admin.Packages().Upload(url, src, "fn", "dev", nil)))
+
+	names, err := admin.Packages().List("function", DefaultNamespace)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"fn"}, names)
+	versions, err := admin.Packages().ListVersions(url)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"v1"}, versions)
+
+	require.NoError(t, admin.Packages().UpdateMetadata(url, "updated", "dev", nil))
+	metadata, err := admin.Packages().GetMetadata(url)
+	require.NoError(t, err)
+	assert.Equal(t, "updated", metadata.Description)
+	assert.NotZero(t, metadata.CreateTime)
+
+	dst := filepath.Join(dir, "out", "fn.jar")
+	require.NoError(t, admin.Packages().Download(url, dst))
+	data, err := os.ReadFile(dst)
+	require.NoError(t, err)
+	assert.Equal(t, "package", string(data))
+
+	require.NoError(t, admin.Packages().Delete(url))
+	_, err = admin.Packages().GetMetadata(url)
+	assert.True(t, pulsaradmin.IsNotFound(err))
+}
+
+func TestServerFunctions(t *testing.T) {
+	admin := newClient(t)
+
+	config := &pulsaradmin.FunctionConfig{
+		Tenant:      DefaultTenant,
+		Namespace:   "default",
+		Name:        "fn",
+		ClassName:   "org.example.Fn",
+		Parallelism: 2,
+	}
+	require.NoError(t, admin.Functions().CreateFuncWithURL(config, "function://public/default/fn@v1"))
+	assert.True(t, pulsaradmin.IsConflict(admin.Functions().CreateFuncWithURL(config, "")))
+
+	names, err := admin.Functions().GetFunctions(DefaultTenant, "default")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"fn"}, names)
+
+	got, err := admin.Functions().GetFunction(DefaultTenant, "default", "fn")
+	require.NoError(t, err)
+	assert.Equal(t, "org.example.Fn", got.ClassName)
+
+	require.NoError(t, admin.Functions().StopFunctionWithID(DefaultTenant, "default", "fn", 1))
+	status, err := admin.Functions().GetFunctionStatus(DefaultTenant, "default", "fn")
+	require.NoError(t, err)
+	assert.Equal(t, 2, status.NumInstances)
+	assert.Equal(t, 1, status.NumRunning)
+
+	require.NoError(t, admin.Functions().DeleteFunction(DefaultTenant, "default", "fn"))
+	_, err = admin.Functions().GetFunction(DefaultTenant, "default", "fn")
+	assert.True(t, pulsaradmin.IsNotFound(err))
+}
+
+func TestServerKeyValueSchemas(t *testing.T) {
+	admin := newClient(t)
+	const topic = "persistent://public/default/orders"
+
+	value := `{"type":"record","name":"Order","fields":[]}`
+	info := pulsaradmin.SchemaInfo{
+		Type: "KEY_VALUE",
+		KeyValue: &pulsaradmin.KeyValueSchemaInfo{
+			Key:      &pulsaradmin.SchemaInfo{Name: "String", Type: "STRING"},
+			Value:    &pulsaradmin.SchemaInfo{Name: "Order", Type: "AVRO", Schema: []byte(value)},
+			Encoding: pulsaradmin.KeyValueEncodingSeparated,
+		},
+	}
+	require.NoError(t, admin.Schemas().CreateSchemaBySchemaInfo(topic, info))
+
+	got, err := admin.Schemas().GetSchemaInfo(topic)
+	require.NoError(t, err)
+	require.NotNil(t, got.KeyValue)
+	assert.Equal(t, pulsaradmin.KeyValueEncodingSeparated, got.KeyValue.Encoding)
+	assert.Equal(t, "STRING", got.KeyValue.Key.Type)
+	assert.Equal(t, "AVRO", got.KeyValue.Value.Type)
+	assert.JSONEq(t, value, string(got.KeyValue.Value.Schema))
+}
+
+func TestServerSchemaVersionsAndCompatibility(t *testing.T) {
+	admin := newClient(t)
+	const topic = "persistent://public/default/orders"
+
+	v0 := pulsaradmin.PostSchemaPayload{SchemaType: "JSON", Schema: `{"type":"record","name":"A","fields":[]}`}
+	v1 := pulsaradmin.PostSchemaPayload{SchemaType: "JSON", Schema: `{"type":"record","name":"B","fields":[]}`}
+
+	compatibility, err := admin.Schemas().TestCompatibility(topic, v0)
+	require.NoError(t, err)
+	assert.True(t, compatibility.IsCompatibility)
+
+	require.NoError(t, admin.Schemas().CreateSchemaByPayload(topic, v0))
+	require.NoError(t, admin.Schemas().CreateSchemaByPayload(topic, v1))
+
+	all, err := admin.Schemas().GetAllSchemas(topic)
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	assert.Equal(t, int64(0), all[0].Version)
+	assert.Equal(t, v0.Schema, string(all[0].SchemaInfo.Schema))
+	assert.Equal(t, v1.Schema, string(all[1].SchemaInfo.Schema))
+
+	version, err := admin.Schemas().GetVersionBySchema(topic, v0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), version)
+	_, err = admin.Schemas().GetVersionBySchema(topic, pulsaradmin.PostSchemaPayload{SchemaType: "STRING"})
+	assert.True(t, pulsaradmin.IsNotFound(err))
+
+	compatibility, err = admin.Schemas().TestCompatibility(topic, pulsaradmin.PostSchemaPayload{SchemaType: "STRING"})
+	require.NoError(t, err)
+	assert.False(t, compatibility.IsCompatibility)
+	assert.Equal(t, "FULL", compatibility.SchemaCompatibilityStrategy)
+}
+
+func TestServerSchemaExportImport(t *testing.T) {
+	source := newClient(t)
+	const topic = "persistent://public/default/orders"
+	orders, err := pulsaradmin.GetTopicName(topic)
+	require.NoError(t, err)
+	plain, err := pulsaradmin.GetTopicName("persistent://public/default/plain")
+	require.NoError(t, err)
+	require.NoError(t, source.Topics().Create(*orders, 2))
+	require.NoError(t, source.Topics().Create(*plain, 0))
+
+	v0 := pulsaradmin.PostSchemaPayload{SchemaType: "JSON", Schema: `{"type":"record","name":"A","fields":[]}`,
+		Properties: map[string]string{"owner": "billing"}}
+	v1 := pulsaradmin.PostSchemaPayload{SchemaType: "JSON",
+		Schema:     `{"type":"record","name":"A","fields":[{"name":"id","type":"long","default":0}]}`,
+		Properties: map[string]string{}}
+	require.NoError(t, source.Schemas().CreateSchemaByPayload(topic, v0))
+	require.NoError(t, source.Schemas().CreateSchemaByPayload(topic, v1))
+
+	dir := t.TempDir()
+	exported, err := pulsaradmin.ExportSchemas(source, dir, DefaultNamespace)
+	require.NoError(t, err)
+	assert.Equal(t, []string{topic}, exported)
+	assert.FileExists(t, filepath.Join(dir, "public", "default", "persistent", "orders.json"))
+
+	target := newClient(t)
+	for i := 0; i < 2; i++ {
+		imported, err := pulsaradmin.ImportSchemas(target, dir)
+		require.NoError(t, err)
+		assert.Equal(t, []string{topic}, imported)
+	}
+
+	want, err := source.Schemas().GetAllSchemas(topic)
+	require.NoError(t, err)
+	got, err := target.Schemas().GetAllSchemas(topic)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	for i := range got {
+		assert.Equal(t, want[i].Version, got[i].Version)
+		assert.Equal(t, want[i].SchemaInfo.Type, got[i].SchemaInfo.Type)
+		assert.Equal(t, want[i].SchemaInfo.Schema, got[i].SchemaInfo.Schema)
+		assert.Equal(t, want[i].SchemaInfo.Properties, got[i].SchemaInfo.Properties)
+		assert.NotZero(t, got[i].SchemaInfo.Timestamp)
+	}
+
+	diverged := newClient(t)
+	require.NoError(t, diverged.Schemas().CreateSchemaByPayload(topic, v1))
+	_, err = pulsaradmin.ImportSchemas(diverged, dir)
+	assert.Error(t, err)
+}
+
+func TestServerSchemaImportRefusesHistories(t *testing.T) {
+	const topic = "persistent://public/default/orders"
+	a := `{"type":"record","name":"A","fields":[]}`
+	b := `{"type":"record","name":"A","fields":[{"name":"id","type":"long","default":0}]}`
+	c := `{"type":"record","name":"A","fields":[{"name":"id","type":"string"}]}`
+	version := func(v int64, schema string) pulsaradmin.GetSchemaResponse {
+		return pulsaradmin.GetSchemaResponse{Version: v, Type: "AVRO", Data: schema}
+	}
+	testcases := []struct {
+		name     string
+		existing []string
+		versions []pulsaradmin.GetSchemaResponse
+		strategy pulsaradmin.SchemaCompatibilityStrategy
+		err      string
+		// created is the number of versions the topic has after the import
+		created int
+	}{
+		{
+			name:     "gap",
+			versions: []pulsaradmin.GetSchemaResponse{version(0, a), version(2, b)},
+			err:      "exported version 2 should be version 1",
+		},
+		{
+			name:     "repeat",
+			versions: []pulsaradmin.GetSchemaResponse{version(0, a), version(1, b), version(2, a)},
+			err:      "version 2 has the schema of version 0",
+		},
+		{
+			name:     "more versions",
+			existing: []string{a, b},
+			versions: []pulsaradmin.GetSchemaResponse{version(0, a)},
+			err:      "the topic has 2 schema versions, more than the 1 exported ones",
+			created:  2,
+		},
+		{
+			name:     "incompatible",
+			versions: []pulsaradmin.GetSchemaResponse{version(0, a), version(1, b), version(2, c)},
+			err:      "version 2 is not compatible under the FULL strategy of the topic",
+			created:  2,
+		},
+		{
+			name:     "namespace strategy",
+			versions: []pulsaradmin.GetSchemaResponse{version(0, a), version(1, b)},
+			strategy: pulsaradmin.AutoUpdateDisabled,
+			err:      "version 1 is not compatible under the ALWAYS_INCOMPATIBLE strategy of the topic",
+			created:  1,
+		},
+	}
+	for _, testcase := range testcases {
+		t.Run(testcase.name, func(t *testing.T) {
+			root := t.TempDir()
+			dir := filepath.Join(root, "public", "default", "persistent")
+			require.NoError(t, os.MkdirAll(dir, 0o755))
+			data, err := json.Marshal(map[string]interface{}{"topic": topic, "versions": testcase.versions})
+			require.NoError(t, err)
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "orders.json"), data, 0o600))
+
+			admin := newClient(t)
+			for _, schema := range testcase.existing {
+				require.NoError(t, admin.Schemas().CreateSchemaByPayload(topic,
+					pulsaradmin.PostSchemaPayload{SchemaType: "AVRO", Schema: schema}))
+			}
+			if testcase.strategy != "" {
+				ns, err := pulsaradmin.GetNamespaceName(DefaultNamespace)
+				require.NoError(t, err)
+				require.NoError(t, admin.Namespaces().SetSchemaAutoUpdateCompatibilityStrategy(*ns, testcase.strategy))
+			}
+			_, err = pulsaradmin.ImportSchemas(admin, root)
+			assert.ErrorContains(t, err, testcase.err)
+
+			all, err := admin.Schemas().GetAllSchemas(topic)
+			require.NoError(t, err)
+			assert.Len(t, all, testcase.created)
+		})
+	}
+}
+
+func TestServerSchemaImportProtobuf(t *testing.T) {
+	const topic = "persistent://public/default/orders"
+	source := newClient(t)
+	orders, err := pulsaradmin.GetTopicName(topic)
+	require.NoError(t, err)
+	require.NoError(t, source.Topics().Create(*orders, 0))
+	for _, schema := range []string{`{"fileDescriptorSet":"v0"}`, `{"fileDescriptorSet":"v1"}`} {
+		require.NoError(t, source.Schemas().CreateSchemaByPayload(topic,
+			pulsaradmin.PostSchemaPayload{SchemaType: "PROTOBUF_NATIVE", Schema: schema}))
+	}
+	dir := t.TempDir()
+	_, err = pulsaradmin.ExportSchemas(source, dir, DefaultNamespace)
+	require.NoError(t, err)
+
+	target := newClient(t)
+	imported, err := pulsaradmin.ImportSchemas(target, dir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{topic}, imported)
+	all, err := target.Schemas().GetAllSchemas(topic)
+	require.NoError(t, err)
+	assert.Len(t, all, 2)
+}
+
+func TestServerPackageChecksum(t *testing.T) {
+	admin := newClient(t)
+	const url = "function://public/default/fn@v1"
+	const checksum = "bc4a71180870f7945155fbb02f4b0a2e3faa2a62d6d31b7039013055ed19869a"
+
+	dir := t.TempDir()
+	src := filepath.Join(dir, "fn.jar")
+	require.NoError(t, os.WriteFile(src, []byte("package"), 0o600))
+	require.NoError(t, admin.Packages().Upload(url, src, "fn", "dev", map[string]string{"k": "v"}))
+
+	metadata, err := admin.Packages().GetMetadata(url)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"k": "v", pulsaradmin.PackageSHA256Property: checksum}, metadata.Properties)
+
+	require.NoError(t, admin.Packages().UpdateMetadata(url, "fn", "dev", map[string]string{"k": "w"}))
+	metadata, err = admin.Packages().GetMetadata(url)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"k": "w", pulsaradmin.PackageSHA256Property: checksum}, metadata.Properties)
+
+	var buf bytes.Buffer
+	require.NoError(t, admin.Packages().DownloadTo(context.Background(), url, &buf, true))
+	assert.Equal(t, "package", buf.String())
+
+	dst := filepath.Join(dir, "out", "fn.jar")
+	options := &pulsaradmin.DownloadOptions{Atomic: true, VerifyChecksum: true}
+	require.NoError(t, admin.Packages().DownloadWithOptions(url, dst, options))
+	data, err := os.ReadFile(dst)
+	require.NoError(t, err)
+	assert.Equal(t, "package", string(data))
+	assert.Error(t, admin.Packages().Download(url, dst))
+	entries, err := os.ReadDir(filepath.Dir(dst))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	require.NoError(t, admin.Packages().UpdateMetadata(url, "fn", "dev",
+		map[string]string{pulsaradmin.PackageSHA256Property: strings.Repeat("0", 64)}))
+	err = admin.Packages().DownloadTo(context.Background(), url, io.Discard, true)
+	assert.ErrorIs(t, err, pulsaradmin.ErrPackageChecksumMismatch)
+
+	tampered := filepath.Join(dir, "tampered.jar")
+	err = admin.Packages().DownloadWithOptions(url, tampered, options)
+	assert.ErrorIs(t, err, pulsaradmin.ErrPackageChecksumMismatch)
+	assert.NoFileExists(t, tampered)
+	require.NoError(t, admin.Packages().Download(url, tampered))
+
+	require.NoError(t, admin.Packages().UpdateMetadata(url, "fn", "dev",
+		map[string]string{pulsaradmin.PackageSHA256Property: ""}))
+	buf.Reset()
+	err = admin.Packages().DownloadTo(context.Background(), url, &buf, true)
+	assert.ErrorIs(t, err, pulsaradmin.ErrPackageChecksumMissing)
+	assert.Empty(t, buf.String())
+	require.NoError(t, admin.Packages().DownloadTo(context.Background(), url, &buf, false))
+	assert.Equal(t, "package", buf.String())
+
+	unverified := filepath.Join(dir, "unverified.jar")
+	err = admin.Packages().DownloadWithOptions(url, unverified, options)
+	assert.ErrorIs(t, err, pulsaradmin.ErrPackageChecksumMissing)
+	assert.NoFileExists(t, unverified)
+	require.NoError(t, admin.Packages().Download(url, unverified))
+}
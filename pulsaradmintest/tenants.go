@@ -0,0 +1,178 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsaradmintest
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+type tenant struct {
+	AdminRoles      []string `json:"adminRoles"`
+	AllowedClusters []string `json:"allowedClusters"`
+}
+
+func (s *Server) serveClusters(w http.ResponseWriter, r *http.Request, parts []string) {
+	if len(parts) == 0 || parts[0] == "" {
+		if r.Method != http.MethodGet {
+			methodNotAllowed(w)
+			return
+		}
+		writeJSON(w, sortedKeys(s.clusters))
+		return
+	}
+	if len(parts) != 1 {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+
+	name := parts[0]
+	data, exists := s.clusters[name]
+	switch r.Method {
+	case http.MethodGet:
+		if !exists {
+			writeError(w, http.StatusNotFound, "Cluster does not exist")
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(data)
+	case http.MethodPut, http.MethodPost:
+		if r.Method == http.MethodPut && exists {
+			writeError(w, http.StatusConflict, "Cluster already exists")
+			return
+		}
+		if r.Method == http.MethodPost && !exists {
+			writeError(w, http.StatusNotFound, "Cluster does not exist")
+			return
+		}
+		var cluster json.RawMessage
+		if !readJSON(w, r, &cluster) {
+			return
+		}
+		if len(cluster) == 0 {
+			cluster = json.RawMessage(`{}`)
+		}
+		s.clusters[name] = cluster
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodDelete:
+		if !exists {
+			writeError(w, http.StatusNotFound, "Cluster does not exist")
+			return
+		}
+		for _, t := range s.tenants {
+			if contains(t.AllowedClusters, name) {
+				writeError(w, http.StatusPreconditionFailed, "Cluster not empty")
+				return
+			}
+		}
+		delete(s.clusters, name)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+func (s *Server) serveTenants(w http.ResponseWriter, r *http.Request, parts []string) {
+	if len(parts) == 0 || parts[0] == "" {
+		if r.Method != http.MethodGet {
+			methodNotAllowed(w)
+			return
+		}
+		writeJSON(w, sortedKeys(s.tenants))
+		return
+	}
+	if len(parts) != 1 {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+
+	name := parts[0]
+	t, exists := s.tenants[name]
+	switch r.Method {
+	case http.MethodGet:
+		if !exists {
+			writeError(w, http.StatusNotFound, "Tenant does not exist")
+			return
+		}
+		writeJSON(w, t)
+	case http.MethodPut, http.MethodPost:
+		if r.Method == http.MethodPut && exists {
+			writeError(w, http.StatusConflict, "Tenant already exists")
+			return
+		}
+		if r.Method == http.MethodPost && !exists {
+			writeError(w, http.StatusNotFound, "Tenant does not exist")
+			return
+		}
+		var data tenant
+		if !readJSON(w, r, &data) {
+			return
+		}
+		for _, cluster := range data.AllowedClusters {
+			if _, ok := s.clusters[cluster]; !ok {
+				writeError(w, http.StatusPreconditionFailed, "Clusters do not exist")
+				return
+			}
+		}
+		s.tenants[name] = &data
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodDelete:
+		if !exists {
+			writeError(w, http.StatusNotFound, "Tenant does not exist")
+			return
+		}
+		if len(s.tenantNamespaces(name)) > 0 {
+			writeError(w, http.StatusConflict, "The tenant still has active namespaces")
+			return
+		}
+		delete(s.tenants, name)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+// tenantNamespaces returns the sorted names of the namespaces of a tenant.
+func (s *Server) tenantNamespaces(tenant string) []string {
+	var names []string
+	for name := range s.namespaces {
+		if strings.HasPrefix(name, tenant+"/") {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
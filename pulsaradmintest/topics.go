@@ -0,0 +1,330 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsaradmintest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	pulsaradmin "github.com/streamnative/pulsar-admin-go"
+)
+
+const partitionSuffix = "-partition-"
+
+type topic struct {
+	// partitions is zero for a non-partitioned topic.
+	partitions    int
+	subscriptions map[string]struct{}
+	permissions   map[string][]string
+	policies      map[string]json.RawMessage
+}
+
+func newTopic(partitions int) *topic {
+	return &topic{
+		partitions:    partitions,
+		subscriptions: make(map[string]struct{}),
+		permissions:   make(map[string][]string),
+		policies:      make(map[string]json.RawMessage),
+	}
+}
+
+// splitTopicName splits a fully qualified topic name into its domain,
+// namespace and local name.
+func splitTopicName(name string) (domain, namespace, local string) {
+	domain, rest, _ := strings.Cut(name, "://")
+	if i := strings.LastIndex(rest, "/"); i >= 0 {
+		return domain, rest[:i], rest[i+1:]
+	}
+	return domain, "", rest
+}
+
+// lookupTopic returns the topic with the given name. A partition of a
+// partitioned topic resolves to the partitioned topic, since the fake keeps
+// no per-partition state.
+func (s *Server) lookupTopic(name string) *topic {
+	if t, ok := s.topics[name]; ok {
+		return t
+	}
+	i := strings.LastIndex(name, partitionSuffix)
+	if i < 0 {
+		return nil
+	}
+	index, err := strconv.Atoi(name[i+len(partitionSuffix):])
+	if err != nil {
+		return nil
+	}
+	if t, ok := s.topics[name[:i]]; ok && index >= 0 && index < t.partitions {
+		return t
+	}
+	return nil
+}
+
+// namespaceTopics returns the sorted topics of a namespace in a domain. The
+// partitioned topics are listed by name when partitioned is set, and by
+// their partitions otherwise.
+func (s *Server) namespaceTopics(domain, namespace string, partitioned bool) []string {
+	names := []string{}
+	for name, t := range s.topics {
+		topicDomain, topicNamespace, _ := splitTopicName(name)
+		if topicDomain != domain || topicNamespace != namespace || (partitioned && t.partitions == 0) {
+			continue
+		}
+		if partitioned || t.partitions == 0 {
+			names = append(names, name)
+			continue
+		}
+		for i := 0; i < t.partitions; i++ {
+			names = append(names, fmt.Sprintf("%s%s%d", name, partitionSuffix, i))
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (s *Server) serveTopics(w http.ResponseWriter, r *http.Request, domain string, parts []string) {
+	if len(parts) < 2 {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	namespace := parts[0] + "/" + parts[1]
+	if _, ok := s.namespaces[namespace]; !ok {
+		writeError(w, http.StatusNotFound, "Namespace does not exist")
+		return
+	}
+	if len(parts) == 2 || (len(parts) == 3 && parts[2] == "partitioned" && r.Method == http.MethodGet) {
+		if r.Method != http.MethodGet {
+			methodNotAllowed(w)
+			return
+		}
+		writeJSON(w, s.namespaceTopics(domain, namespace, len(parts) == 3))
+		return
+	}
+
+	name := domain + "://" + namespace + "/" + parts[2]
+	rest := parts[3:]
+	if len(rest) == 0 {
+		s.serveNonPartitionedTopic(w, r, name)
+		return
+	}
+	if rest[0] == "partitions" {
+		s.servePartitionedTopic(w, r, name)
+		return
+	}
+
+	t := s.lookupTopic(name)
+	if t == nil {
+		writeError(w, http.StatusNotFound, "Topic not found")
+		return
+	}
+	switch rest[0] {
+	case "stats":
+		writeJSON(w, topicStats(t))
+	case "partitioned-stats":
+		if t.partitions == 0 {
+			writeError(w, http.StatusNotFound, "Partitioned topic does not exist")
+			return
+		}
+		stats := topicStats(t)
+		writeJSON(w, pulsaradmin.PartitionedTopicStats{
+			Publishers:    stats.Publishers,
+			Subscriptions: stats.Subscriptions,
+			Replication:   stats.Replication,
+			Metadata:      pulsaradmin.PartitionedTopicMetadata{Partitions: t.partitions},
+			Partitions:    map[string]pulsaradmin.TopicStats{},
+		})
+	case "permissions":
+		servePermissions(w, r, t.permissions, rest[1:])
+	case "subscriptions":
+		if r.Method != http.MethodGet {
+			methodNotAllowed(w)
+			return
+		}
+		writeJSON(w, sortedKeys(t.subscriptions))
+	case "subscription":
+		s.serveSubscription(w, r, t, rest[1:])
+	case "all_subscription":
+		if r.Method != http.MethodPost {
+			methodNotAllowed(w)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case "ledger":
+		writeError(w, http.StatusNotFound, "Message not found")
+	default:
+		servePolicy(w, r, t.policies, strings.Join(rest, "/"))
+	}
+}
+
+func (s *Server) serveNonPartitionedTopic(w http.ResponseWriter, r *http.Request, name string) {
+	switch r.Method {
+	case http.MethodPut:
+		if s.lookupTopic(name) != nil {
+			writeError(w, http.StatusConflict, "This topic already exists")
+			return
+		}
+		s.topics[name] = newTopic(0)
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodDelete:
+		t, ok := s.topics[name]
+		if !ok || t.partitions > 0 {
+			writeError(w, http.StatusNotFound, "Topic not found")
+			return
+		}
+		if !deletable(w, r, t) {
+			return
+		}
+		delete(s.topics, name)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+func (s *Server) servePartitionedTopic(w http.ResponseWriter, r *http.Request, name string) {
+	t, ok := s.topics[name]
+	partitioned := ok && t.partitions > 0
+	switch r.Method {
+	case http.MethodGet:
+		// Like the broker, report zero partitions for a topic that is not
+		// partitioned, whether it exists or not.
+		metadata := pulsaradmin.PartitionedTopicMetadata{}
+		if partitioned {
+			metadata.Partitions = t.partitions
+		}
+		writeJSON(w, metadata)
+	case http.MethodPut:
+		var partitions int
+		if !readJSON(w, r, &partitions) {
+			return
+		}
+		if partitions <= 0 {
+			writeError(w, http.StatusNotAcceptable, "Number of partitions should be more than 0")
+			return
+		}
+		if s.lookupTopic(name) != nil {
+			writeError(w, http.StatusConflict, "This topic already exists")
+			return
+		}
+		s.topics[name] = newTopic(partitions)
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodPost:
+		var partitions int
+		if !readJSON(w, r, &partitions) {
+			return
+		}
+		if !partitioned {
+			writeError(w, http.StatusNotFound, "Partitioned topic does not exist")
+			return
+		}
+		if partitions < t.partitions {
+			writeError(w, http.StatusUnprocessableEntity,
+				"Desired partitions %d can't be less than the current partitions %d", partitions, t.partitions)
+			return
+		}
+		t.partitions = partitions
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodDelete:
+		if !partitioned {
+			writeError(w, http.StatusNotFound, "Partitioned topic does not exist")
+			return
+		}
+		if !deletable(w, r, t) {
+			return
+		}
+		delete(s.topics, name)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+// deletable reports whether the topic can be deleted, after writing a 412
+// response when it still has subscriptions and the deletion is not forced.
+func deletable(w http.ResponseWriter, r *http.Request, t *topic) bool {
+	if len(t.subscriptions) > 0 && r.URL.Query().Get("force") != "true" {
+		writeError(w, http.StatusPreconditionFailed, "Topic has active producers/subscriptions")
+		return false
+	}
+	return true
+}
+
+func topicStats(t *topic) pulsaradmin.TopicStats {
+	stats := pulsaradmin.TopicStats{
+		Publishers:    []pulsaradmin.PublisherStats{},
+		Subscriptions: make(map[string]pulsaradmin.SubscriptionStats, len(t.subscriptions)),
+		Replication:   map[string]pulsaradmin.ReplicatorStats{},
+	}
+	for name := range t.subscriptions {
+		stats.Subscriptions[name] = pulsaradmin.SubscriptionStats{}
+	}
+	return stats
+}
+
+func (s *Server) serveSubscription(w http.ResponseWriter, r *http.Request, t *topic, parts []string) {
+	if len(parts) == 0 {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	name := parts[0]
+	_, exists := t.subscriptions[name]
+
+	if len(parts) == 1 {
+		switch r.Method {
+		case http.MethodPut:
+			if exists {
+				writeError(w, http.StatusConflict, "Subscription already exists for topic")
+				return
+			}
+			var position pulsaradmin.MessageID
+			if !readJSON(w, r, &position) {
+				return
+			}
+			t.subscriptions[name] = struct{}{}
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodDelete:
+			if !exists {
+				writeError(w, http.StatusNotFound, "Subscription not found")
+				return
+			}
+			delete(t.subscriptions, name)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	if !exists {
+		writeError(w, http.StatusNotFound, "Subscription not found")
+		return
+	}
+	switch parts[1] {
+	case "position":
+		writeError(w, http.StatusNotFound, "Message not found")
+	case "resetcursor", "skip_all", "skip", "expireMessages":
+		if r.Method != http.MethodPost {
+			methodNotAllowed(w)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusNotFound, "Not Found")
+	}
+}
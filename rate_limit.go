@@ -0,0 +1,157 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsaradmin
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"golang.org/x/time/rate"
+
+	"github.com/streamnative/pulsar-admin-go/internal/rest"
+)
+
+// RateLimit caps the rate and the concurrency of admin calls. Calls over the
+// limits wait for their turn, or until the context bound to the client is done.
+type RateLimit struct {
+	// RequestsPerSecond is the sustained rate of calls, refilled as a token
+	// bucket. Zero means no rate limit.
+	RequestsPerSecond float64
+	// Burst is the number of calls that can be made at once on top of the
+	// sustained rate. Default is RequestsPerSecond rounded up.
+	Burst int
+	// MaxInFlight is the maximum number of concurrent calls, each of which is
+	// in flight until its response body has been read and closed, such as a
+	// whole package download. Zero means no concurrency limit.
+	MaxInFlight int
+}
+
+func (l RateLimit) validate() error {
+	if l.RequestsPerSecond < 0 || l.Burst < 0 || l.MaxInFlight < 0 {
+		return fmt.Errorf("invalid rate limit %+v: values must not be negative", l)
+	}
+	return nil
+}
+
+type rateLimiter struct {
+	limiter  *rate.Limiter
+	inFlight chan struct{}
+}
+
+func newRateLimiter(l RateLimit) *rateLimiter {
+	limiter := &rateLimiter{}
+	if l.RequestsPerSecond > 0 {
+		burst := l.Burst
+		if burst == 0 {
+			burst = int(math.Ceil(l.RequestsPerSecond))
+		}
+		limiter.limiter = rate.NewLimiter(rate.Limit(l.RequestsPerSecond), burst)
+	}
+	if l.MaxInFlight > 0 {
+		limiter.inFlight = make(chan struct{}, l.MaxInFlight)
+	}
+	return limiter
+}
+
+func (l *rateLimiter) acquire(ctx context.Context) (func(), error) {
+	if l.inFlight != nil {
+		select {
+		case l.inFlight <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	release := func() {
+		if l.inFlight != nil {
+			<-l.inFlight
+		}
+	}
+	if l.limiter != nil {
+		if err := l.limiter.Wait(ctx); err != nil {
+			release()
+			return nil, err
+		}
+	}
+	return release, nil
+}
+
+// clientLimiter applies the limit of the operation group of a call, such as
+// "Topics" or "Functions", then the global limit.
+type clientLimiter struct {
+	global *rateLimiter
+	groups map[string]*rateLimiter
+}
+
+var _ rest.Limiter = &clientLimiter{}
+
+func newClientLimiter(global *RateLimit, groups map[string]RateLimit) (*clientLimiter, error) {
+	limiter := &clientLimiter{
+		groups: make(map[string]*rateLimiter, len(groups)),
+	}
+	if global != nil {
+		if err := global.validate(); err != nil {
+			return nil, err
+		}
+		limiter.global = newRateLimiter(*global)
+	}
+	for group, l := range groups {
+		if !isOperationGroup(group) {
+			return nil, fmt.Errorf("unknown resource group %q", group)
+		}
+		if err := l.validate(); err != nil {
+			return nil, fmt.Errorf("%s: %w", group, err)
+		}
+		limiter.groups[group] = newRateLimiter(l)
+	}
+	return limiter, nil
+}
+
+func (l *clientLimiter) Acquire(ctx context.Context, call *rest.Call) (func(), error) {
+	var releases []func()
+	release := func() {
+		for i := len(releases) - 1; i >= 0; i-- {
+			releases[i]()
+		}
+	}
+
+	group := call.Operation
+	if i := strings.Index(group, "."); i >= 0 {
+		group = group[:i]
+	}
+	for _, limiter := range []*rateLimiter{l.groups[group], l.global} {
+		if limiter == nil {
+			continue
+		}
+		r, err := limiter.acquire(ctx)
+		if err != nil {
+			release()
+			return nil, err
+		}
+		releases = append(releases, r)
+	}
+	return release, nil
+}
+
+func isOperationGroup(name string) bool {
+	for _, group := range operationGroups {
+		if group == name {
+			return true
+		}
+	}
+	return false
+}
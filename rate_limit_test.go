@@ -0,0 +1,149 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsaradmin
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimitMaxInFlight(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		for {
+			m := atomic.LoadInt32(&maxInFlight)
+			if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+		_, _ = w.Write([]byte("[]"))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		WebServiceURL: server.URL,
+		RateLimit:     &RateLimit{MaxInFlight: 2},
+	})
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.Tenants().List()
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(2), atomic.LoadInt32(&maxInFlight))
+}
+
+func TestRateLimitMaxInFlightHoldsUntilBodyClosed(t *testing.T) {
+	unblock := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/admin/v3/packages/") {
+			_, _ = w.Write([]byte("part"))
+			w.(http.Flusher).Flush()
+			<-unblock
+			_, _ = w.Write([]byte("rest"))
+			return
+		}
+		_, _ = w.Write([]byte("[]"))
+	}))
+	defer server.Close()
+	var once sync.Once
+	release := func() { once.Do(func() { close(unblock) }) }
+	defer release()
+
+	client, err := NewClient(ClientConfig{
+		WebServiceURL: server.URL,
+		RateLimit:     &RateLimit{MaxInFlight: 1},
+	})
+	require.NoError(t, err)
+
+	var data bytes.Buffer
+	downloaded := make(chan error, 1)
+	go func() {
+		downloaded <- client.Packages().DownloadTo(context.Background(), "function://public/default/fn@v1", &data, false)
+	}()
+
+	// the download holds the only slot while its body is being read
+	require.Eventually(t, func() bool {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		_, err := client.WithContext(ctx).Tenants().List()
+		return errors.Is(err, context.DeadlineExceeded)
+	}, time.Second, time.Millisecond)
+
+	release()
+	require.NoError(t, <-downloaded)
+	assert.Equal(t, "partrest", data.String())
+	_, err = client.Tenants().List()
+	assert.NoError(t, err)
+}
+
+func TestGroupRateLimitRespectsContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		WebServiceURL:   server.URL,
+		GroupRateLimits: map[string]RateLimit{"Topics": {RequestsPerSecond: 0.1, Burst: 1}},
+	})
+	require.NoError(t, err)
+
+	topic, err := GetTopicName("public/default/test")
+	require.NoError(t, err)
+
+	_, err = client.Topics().GetStats(*topic)
+	require.NoError(t, err)
+
+	// other groups are not limited
+	for i := 0; i < 3; i++ {
+		_, err = client.Tenants().Get("public")
+		require.NoError(t, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = client.WithContext(ctx).Topics().GetStats(*topic)
+	require.Error(t, err)
+}
+
+func TestRateLimitValidation(t *testing.T) {
+	_, err := NewClient(ClientConfig{RateLimit: &RateLimit{MaxInFlight: -1}})
+	assert.Error(t, err)
+
+	_, err = NewClient(ClientConfig{GroupRateLimits: map[string]RateLimit{"Topic": {MaxInFlight: 1}}})
+	assert.Error(t, err)
+}
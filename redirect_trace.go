@@ -0,0 +1,66 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsaradmin
+
+import (
+	"context"
+	"net/url"
+	"sync"
+
+	"github.com/streamnative/pulsar-admin-go/internal/rest"
+)
+
+// RedirectHop is a single redirect followed by the client, typically from a
+// broker to the broker that owns the requested resource.
+type RedirectHop struct {
+	Method     string
+	StatusCode int
+	From       string
+	To         string
+}
+
+// RedirectTrace records the redirects followed by admin calls bound to a
+// context returned by WithRedirectTrace. It is safe for concurrent use.
+type RedirectTrace struct {
+	mu   sync.Mutex
+	hops []RedirectHop
+}
+
+// Hops returns the redirects recorded so far, in the order they were followed.
+func (t *RedirectTrace) Hops() []RedirectHop {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	hops := make([]RedirectHop, len(t.hops))
+	copy(hops, t.hops)
+	return hops
+}
+
+func (t *RedirectTrace) record(method string, statusCode int, from, to *url.URL) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.hops = append(t.hops, RedirectHop{
+		Method:     method,
+		StatusCode: statusCode,
+		From:       from.String(),
+		To:         to.String(),
+	})
+}
+
+// WithRedirectTrace returns a copy of ctx that records into trace every
+// redirect followed by the admin calls bound to it with Client.WithContext.
+func WithRedirectTrace(ctx context.Context, trace *RedirectTrace) context.Context {
+	return rest.WithRedirectHook(ctx, trace.record)
+}
@@ -0,0 +1,84 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsaradmin
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientFollowsRedirectWithBodyAndAuth(t *testing.T) {
+	var uploaded []byte
+	var authorization string
+	owner := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		uploaded, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer owner.Close()
+	broker := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, owner.URL+r.URL.RequestURI(), http.StatusTemporaryRedirect)
+	}))
+	defer broker.Close()
+
+	file := filepath.Join(t.TempDir(), "function.jar")
+	require.NoError(t, os.WriteFile(file, []byte("function-content"), 0o600))
+
+	client, err := NewClient(ClientConfig{
+		WebServiceURL: broker.URL,
+		AuthProvider:  AuthProviderToken("my-token"),
+	})
+	require.NoError(t, err)
+
+	var trace RedirectTrace
+	ctx := WithRedirectTrace(context.Background(), &trace)
+	err = client.WithContext(ctx).Packages().Upload("function://public/default/test@v1", file, "", "", nil)
+	require.NoError(t, err)
+
+	assert.Contains(t, string(uploaded), "function-content")
+	assert.Equal(t, "Bearer my-token", authorization)
+	hops := trace.Hops()
+	require.Len(t, hops, 1)
+	assert.Equal(t, http.MethodPost, hops[0].Method)
+	assert.Equal(t, http.StatusTemporaryRedirect, hops[0].StatusCode)
+	assert.Equal(t, owner.URL+"/admin/v3/packages/function/public/default/test/v1", hops[0].To)
+}
+
+func TestClientStopsAfterMaxRedirects(t *testing.T) {
+	var requests int
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		http.Redirect(w, r, server.URL+r.URL.RequestURI(), http.StatusTemporaryRedirect)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{WebServiceURL: server.URL, MaxRedirects: 2})
+	require.NoError(t, err)
+
+	_, err = client.Tenants().List()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "stopped after 2 redirects")
+	assert.Equal(t, 3, requests)
+}
@@ -0,0 +1,190 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsaradmin
+
+import (
+	"fmt"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/streamnative/pulsar-admin-go/internal/rest"
+)
+
+// RetryPolicy decides whether a failed attempt of an admin call is retried.
+//
+// Retry is called after every attempt that failed with a transport error or a
+// non-2xx response. attempt starts at 1; resp is nil whenever err is not. It
+// returns how long to wait before the next attempt and whether there should be
+// one at all. Requests whose body cannot be replayed, or whose context is
+// done, are never retried regardless of the policy.
+type RetryPolicy interface {
+	Retry(attempt int, req *http.Request, resp *http.Response, err error) (time.Duration, bool)
+}
+
+// The following are the defaults used by BackoffRetryPolicy for unset fields.
+const (
+	DefaultRetryMaxAttempts    = 3
+	DefaultRetryInitialBackoff = 100 * time.Millisecond
+	DefaultRetryMaxBackoff     = 5 * time.Second
+)
+
+// DefaultRetryableStatusCodes are the HTTP status codes retried by
+// BackoffRetryPolicy when RetryableStatusCodes is nil.
+var DefaultRetryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// BackoffRetryPolicy is a RetryPolicy that waits an exponentially growing,
+// jittered delay between attempts. A Retry-After header sent by the broker
+// takes precedence over the computed delay, capped by MaxBackoff.
+//
+// Requests with non-idempotent methods (POST, PATCH) are only retried when
+// the connection to the broker could not be established, unless
+// RetryNonIdempotent is set.
+type BackoffRetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts per call, including the
+	// first one. Default is DefaultRetryMaxAttempts.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry. Default is
+	// DefaultRetryInitialBackoff.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between two attempts. Default is
+	// DefaultRetryMaxBackoff.
+	MaxBackoff time.Duration
+	// Jitter is the fraction, between 0 and 1, of each delay that is
+	// randomized. Zero disables jitter.
+	Jitter float64
+	// RetryableStatusCodes are the response codes that cause a retry. Default
+	// is DefaultRetryableStatusCodes.
+	RetryableStatusCodes []int
+	// RetryNonIdempotent allows non-idempotent requests to be retried after
+	// they may have reached the broker.
+	RetryNonIdempotent bool
+}
+
+var _ RetryPolicy = &BackoffRetryPolicy{}
+
+// Retry implements RetryPolicy.
+func (p *BackoffRetryPolicy) Retry(attempt int, req *http.Request, resp *http.Response, err error) (time.Duration,
+	bool,
+) {
+	maxAttempts := p.MaxAttempts
+	if maxAttempts == 0 {
+		maxAttempts = DefaultRetryMaxAttempts
+	}
+	if attempt >= maxAttempts {
+		return 0, false
+	}
+
+	if err != nil {
+		if !isIdempotent(req.Method) && !p.RetryNonIdempotent && !rest.IsDialError(err) {
+			return 0, false
+		}
+		return p.backoff(attempt), true
+	}
+
+	if !p.isRetryableStatus(resp.StatusCode) {
+		return 0, false
+	}
+	if !isIdempotent(req.Method) && !p.RetryNonIdempotent {
+		return 0, false
+	}
+	if delay, ok := retryAfter(resp); ok {
+		if maxBackoff := p.maxBackoff(); delay > maxBackoff {
+			delay = maxBackoff
+		}
+		return delay, true
+	}
+	return p.backoff(attempt), true
+}
+
+// Validate returns an error if a field of the policy is out of range.
+func (p *BackoffRetryPolicy) Validate() error {
+	switch {
+	case p.MaxAttempts < 0:
+		return fmt.Errorf("retry policy: negative MaxAttempts %d", p.MaxAttempts)
+	case p.InitialBackoff < 0:
+		return fmt.Errorf("retry policy: negative InitialBackoff %s", p.InitialBackoff)
+	case p.MaxBackoff < 0:
+		return fmt.Errorf("retry policy: negative MaxBackoff %s", p.MaxBackoff)
+	case !(p.Jitter >= 0 && p.Jitter <= 1):
+		return fmt.Errorf("retry policy: Jitter %v is not between 0 and 1", p.Jitter)
+	}
+	return nil
+}
+
+func (p *BackoffRetryPolicy) maxBackoff() time.Duration {
+	if p.MaxBackoff == 0 {
+		return DefaultRetryMaxBackoff
+	}
+	return p.MaxBackoff
+}
+
+func (p *BackoffRetryPolicy) backoff(attempt int) time.Duration {
+	initial := p.InitialBackoff
+	if initial == 0 {
+		initial = DefaultRetryInitialBackoff
+	}
+	maxBackoff := p.maxBackoff()
+
+	delay := initial
+	for i := 1; i < attempt && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > maxBackoff {
+		delay = maxBackoff
+	}
+	if p.Jitter > 0 {
+		jitter := time.Duration(p.Jitter * float64(delay))
+		delay = delay - jitter + time.Duration(rand.Int63n(int64(jitter)+1)) //nolint:gosec
+	}
+	return delay
+}
+
+func (p *BackoffRetryPolicy) isRetryableStatus(code int) bool {
+	codes := p.RetryableStatusCodes
+	if codes == nil {
+		codes = DefaultRetryableStatusCodes
+	}
+	for _, c := range codes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// retryAfter parses the delay from a Retry-After header expressed in seconds.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	seconds, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || seconds < 0 {
+		return 0, false
+	}
+	return time.Duration(seconds) * time.Second, true
+}
@@ -0,0 +1,142 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsaradmin
+
+import (
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackoffRetryPolicy(t *testing.T) {
+	policy := &BackoffRetryPolicy{MaxAttempts: 4, InitialBackoff: time.Second, MaxBackoff: 3 * time.Second}
+	get, _ := http.NewRequest(http.MethodGet, "http://localhost", nil)
+	post, _ := http.NewRequest(http.MethodPost, "http://localhost", nil)
+	unavailable := &http.Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{}}
+
+	delay, ok := policy.Retry(1, get, unavailable, nil)
+	assert.True(t, ok)
+	assert.Equal(t, time.Second, delay)
+
+	delay, ok = policy.Retry(2, get, unavailable, nil)
+	assert.True(t, ok)
+	assert.Equal(t, 2*time.Second, delay)
+
+	delay, ok = policy.Retry(3, get, unavailable, nil)
+	assert.True(t, ok)
+	assert.Equal(t, 3*time.Second, delay)
+
+	_, ok = policy.Retry(4, get, unavailable, nil)
+	assert.False(t, ok)
+
+	_, ok = policy.Retry(1, get, &http.Response{StatusCode: http.StatusNotFound}, nil)
+	assert.False(t, ok)
+
+	_, ok = policy.Retry(1, post, unavailable, nil)
+	assert.False(t, ok)
+
+	_, ok = policy.Retry(1, post, nil, errors.New("connection reset by peer"))
+	assert.False(t, ok)
+
+	_, ok = policy.Retry(1, post, nil, &net.OpError{Op: "dial", Err: errors.New("connection refused")})
+	assert.True(t, ok)
+
+	throttled := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": {"2"}}}
+	delay, ok = policy.Retry(1, get, throttled, nil)
+	assert.True(t, ok)
+	assert.Equal(t, 2*time.Second, delay)
+
+	// a Retry-After longer than MaxBackoff is capped
+	throttled.Header.Set("Retry-After", "3600")
+	delay, ok = policy.Retry(1, get, throttled, nil)
+	assert.True(t, ok)
+	assert.Equal(t, 3*time.Second, delay)
+}
+
+func TestBackoffRetryPolicyValidate(t *testing.T) {
+	assert.NoError(t, (&BackoffRetryPolicy{}).Validate())
+	assert.NoError(t, (&BackoffRetryPolicy{Jitter: 1}).Validate())
+	assert.Error(t, (&BackoffRetryPolicy{Jitter: -0.1}).Validate())
+	assert.Error(t, (&BackoffRetryPolicy{Jitter: 1.5}).Validate())
+	assert.Error(t, (&BackoffRetryPolicy{MaxBackoff: -time.Second}).Validate())
+
+	_, err := NewClient(ClientConfig{RetryPolicy: &BackoffRetryPolicy{Jitter: 2}})
+	assert.Error(t, err)
+}
+
+func TestBackoffRetryPolicyJitter(t *testing.T) {
+	policy := &BackoffRetryPolicy{InitialBackoff: time.Second, Jitter: 0.5}
+	for i := 0; i < 100; i++ {
+		delay := policy.backoff(1)
+		assert.GreaterOrEqual(t, delay, 500*time.Millisecond)
+		assert.LessOrEqual(t, delay, time.Second)
+	}
+}
+
+func TestClientRetriesAndReplaysBody(t *testing.T) {
+	var attempts int32
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		WebServiceURL: server.URL,
+		RetryPolicy:   &BackoffRetryPolicy{InitialBackoff: time.Millisecond},
+	})
+	require.NoError(t, err)
+
+	err = client.Tenants().Create(TenantData{Name: "tenant"})
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&attempts))
+	require.Len(t, bodies, 3)
+	assert.Equal(t, bodies[0], bodies[1])
+	assert.Equal(t, bodies[0], bodies[2])
+}
+
+func TestClientDoesNotRetryPostByDefault(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		WebServiceURL: server.URL,
+		RetryPolicy:   &BackoffRetryPolicy{InitialBackoff: time.Millisecond},
+	})
+	require.NoError(t, err)
+
+	err = client.Tenants().Update(TenantData{Name: "tenant"})
+	require.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&attempts))
+}
@@ -0,0 +1,588 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsaradmin
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// SchemaCompatibility is the result of a local schema compatibility check.
+type SchemaCompatibility struct {
+	Compatible        bool
+	Incompatibilities []SchemaIncompatibility
+}
+
+// SchemaIncompatibility is a change making a new schema incompatible with an
+// existing one.
+type SchemaIncompatibility struct {
+	// Version is the index, in the existing schemas, of the schema the new
+	// schema is incompatible with.
+	Version int
+	// Forward is set when the existing schema cannot read the data written
+	// with the new schema, and unset when the new schema cannot read the data
+	// written with the existing one.
+	Forward bool
+	// Path locates the change, such as Order.lines[].price.
+	Path    string
+	Message string
+}
+
+func (i SchemaIncompatibility) String() string {
+	if i.Path == "" {
+		return fmt.Sprintf("version %d: %s", i.Version, i.Message)
+	}
+	return fmt.Sprintf("version %d: %s: %s", i.Version, i.Path, i.Message)
+}
+
+// CheckSchemaCompatibility checks offline whether a new schema can follow
+// the existing schemas of a topic, oldest first, under a compatibility
+// strategy, like the broker does. The non-transitive strategies check the
+// new schema against the latest existing schema only.
+//
+// AVRO and JSON schemas are checked with the Avro schema resolution rules,
+// KEY_VALUE schemas by their key and value schemas, and the other schema
+// types only for a change of type. A JSON schema must have an Avro
+// definition, as produced by the Pulsar clients.
+func CheckSchemaCompatibility(existing []SchemaInfo, schema SchemaInfo,
+	strategy SchemaCompatibilityStrategy,
+) (*SchemaCompatibility, error) {
+	result := &SchemaCompatibility{Compatible: true}
+	if len(existing) == 0 || strategy == AlwaysCompatible {
+		return result, nil
+	}
+
+	var backward, forward bool
+	versions := []int{len(existing) - 1}
+	switch strategy {
+	case AutoUpdateDisabled:
+		latest := existing[len(existing)-1]
+		if !sameSchema(&latest, &schema) {
+			result.add(SchemaIncompatibility{Version: len(existing) - 1, Message: "schema updates are disabled"})
+		}
+		return result, nil
+	case Backward, BackwardTransitive:
+		backward = true
+	case Forward, ForwardTransitive:
+		forward = true
+	case Full, FullTransitive:
+		backward, forward = true, true
+	default:
+		return nil, fmt.Errorf("unknown schema compatibility strategy %q", strategy)
+	}
+	if strategy == BackwardTransitive || strategy == ForwardTransitive || strategy == FullTransitive {
+		versions = versions[:0]
+		for i := range existing {
+			versions = append(versions, i)
+		}
+	}
+
+	for _, version := range versions {
+		if backward {
+			incompatibilities, err := checkSchemaInfo(&schema, &existing[version], false)
+			if err != nil {
+				return nil, fmt.Errorf("version %d: %w", version, err)
+			}
+			result.addAll(version, incompatibilities)
+		}
+		if forward {
+			incompatibilities, err := checkSchemaInfo(&existing[version], &schema, true)
+			if err != nil {
+				return nil, fmt.Errorf("version %d: %w", version, err)
+			}
+			result.addAll(version, incompatibilities)
+		}
+	}
+	return result, nil
+}
+
+func (r *SchemaCompatibility) add(incompatibility SchemaIncompatibility) {
+	r.Compatible = false
+	r.Incompatibilities = append(r.Incompatibilities, incompatibility)
+}
+
+func (r *SchemaCompatibility) addAll(version int, incompatibilities []SchemaIncompatibility) {
+	for _, incompatibility := range incompatibilities {
+		incompatibility.Version = version
+		r.add(incompatibility)
+	}
+}
+
+// sameSchema reports whether two schemas have the same type and definition.
+func sameSchema(a, b *SchemaInfo) bool {
+	if !strings.EqualFold(a.Type, b.Type) {
+		return false
+	}
+	if bytes.Equal(a.Schema, b.Schema) {
+		return true
+	}
+	var aDef, bDef interface{}
+	return json.Unmarshal(a.Schema, &aDef) == nil && json.Unmarshal(b.Schema, &bDef) == nil &&
+		reflect.DeepEqual(aDef, bDef)
+}
+
+// checkSchemaInfo returns the changes preventing the reader schema from
+// reading the data written with the writer schema. forward tells whether the
+// reader is the existing schema, for the messages.
+func checkSchemaInfo(reader, writer *SchemaInfo, forward bool) ([]SchemaIncompatibility, error) {
+	c := &avroChecker{forward: forward, seen: make(map[[2]*avroType]bool)}
+	readerType, writerType := strings.ToUpper(reader.Type), strings.ToUpper(writer.Type)
+	if readerType != writerType {
+		old, updated := c.change(readerType, writerType)
+		c.report("", "schema type changed from %s to %s", old, updated)
+		return c.incompatibilities, nil
+	}
+
+	switch readerType {
+	case "AVRO", "JSON":
+		readerAvro, err := parseAvroSchema(reader.Schema)
+		if err != nil {
+			return nil, fmt.Errorf("%s schema: %w", readerType, err)
+		}
+		writerAvro, err := parseAvroSchema(writer.Schema)
+		if err != nil {
+			return nil, fmt.Errorf("%s schema: %w", writerType, err)
+		}
+		c.check(readerAvro, writerAvro, "")
+		return c.incompatibilities, nil
+	case "KEY_VALUE":
+		readerKV, err := keyValueOf(reader)
+		if err != nil {
+			return nil, err
+		}
+		writerKV, err := keyValueOf(writer)
+		if err != nil {
+			return nil, err
+		}
+		var all []SchemaIncompatibility
+		for _, side := range []struct {
+			path           string
+			reader, writer *SchemaInfo
+		}{{"key", readerKV.Key, writerKV.Key}, {"value", readerKV.Value, writerKV.Value}} {
+			incompatibilities, err := checkSchemaInfo(side.reader, side.writer, forward)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", side.path, err)
+			}
+			for _, incompatibility := range incompatibilities {
+				incompatibility.Path = joinPath(side.path, incompatibility.Path)
+				all = append(all, incompatibility)
+			}
+		}
+		return all, nil
+	case "PROTOBUF", "PROTOBUF_NATIVE", "AUTO_CONSUME", "AUTO_PUBLISH":
+		return nil, fmt.Errorf("compatibility of %s schemas is not supported", readerType)
+	default:
+		// the primitive schemas have no definition
+		return nil, nil
+	}
+}
+
+func keyValueOf(info *SchemaInfo) (*KeyValueSchemaInfo, error) {
+	if info.KeyValue != nil {
+		return info.KeyValue, nil
+	}
+	return decodeKeyValueSchema(string(info.Schema), info.Properties)
+}
+
+func joinPath(parent, child string) string {
+	switch {
+	case parent == "":
+		return child
+	case child == "":
+		return parent
+	case strings.HasPrefix(child, "["), strings.HasPrefix(child, "{"):
+		return parent + child
+	}
+	return parent + "." + child
+}
+
+// avroType is a parsed Avro schema.
+type avroType struct {
+	// kind is a primitive type name, record, enum, fixed, array, map or
+	// union.
+	kind string
+	// name is the full name of a named type.
+	name    string
+	aliases []string
+	fields  []*avroField
+	symbols []string
+	// enumDefault is the symbol an enum reads unknown symbols as.
+	enumDefault string
+	size        int
+	// items is the type of the items of an array, or of the values of a map.
+	items    *avroType
+	branches []*avroType
+}
+
+type avroField struct {
+	name       string
+	aliases    []string
+	typ        *avroType
+	hasDefault bool
+}
+
+var avroPrimitives = map[string]bool{
+	"null": true, "boolean": true, "int": true, "long": true, "float": true, "double": true, "bytes": true,
+	"string": true,
+}
+
+// avroPromotions are the writer types a reader type can read, besides its
+// own type.
+var avroPromotions = map[string][]string{
+	"long":   {"int"},
+	"float":  {"int", "long"},
+	"double": {"int", "long", "float"},
+	"string": {"bytes"},
+	"bytes":  {"string"},
+}
+
+func parseAvroSchema(definition []byte) (*avroType, error) {
+	var raw interface{}
+	if err := json.Unmarshal(definition, &raw); err != nil {
+		return nil, fmt.Errorf("invalid definition: %w", err)
+	}
+	p := &avroParser{named: make(map[string]*avroType)}
+	return p.parse(raw, "")
+}
+
+type avroParser struct {
+	named map[string]*avroType
+}
+
+func (p *avroParser) parse(raw interface{}, namespace string) (*avroType, error) {
+	switch v := raw.(type) {
+	case string:
+		if avroPrimitives[v] {
+			return &avroType{kind: v}, nil
+		}
+		if t, ok := p.named[fullName(v, namespace)]; ok {
+			return t, nil
+		}
+		if t, ok := p.named[v]; ok {
+			return t, nil
+		}
+		return nil, fmt.Errorf("unknown type %q", v)
+	case []interface{}:
+		union := &avroType{kind: "union"}
+		for _, branch := range v {
+			t, err := p.parse(branch, namespace)
+			if err != nil {
+				return nil, err
+			}
+			union.branches = append(union.branches, t)
+		}
+		return union, nil
+	case map[string]interface{}:
+		return p.parseObject(v, namespace)
+	}
+	return nil, fmt.Errorf("invalid type %v", raw)
+}
+
+func (p *avroParser) parseObject(v map[string]interface{}, namespace string) (*avroType, error) {
+	kind, _ := v["type"].(string)
+	if kind == "" {
+		if _, ok := v["$schema"]; ok {
+			return nil, fmt.Errorf("JSON Schema definitions are not supported, only Avro definitions")
+		}
+		if nested, ok := v["type"]; ok {
+			return p.parse(nested, namespace)
+		}
+		return nil, fmt.Errorf("missing type")
+	}
+
+	switch kind {
+	case "record", "error", "enum", "fixed":
+		name, _ := v["name"].(string)
+		if name == "" {
+			return nil, fmt.Errorf("%s without a name", kind)
+		}
+		if ns, ok := v["namespace"].(string); ok && !strings.Contains(name, ".") {
+			namespace = ns
+		}
+		t := &avroType{kind: kind, name: fullName(name, namespace), aliases: stringList(v["aliases"])}
+		if kind == "error" {
+			t.kind = "record"
+		}
+		if i := strings.LastIndex(t.name, "."); i >= 0 {
+			namespace = t.name[:i]
+		} else {
+			namespace = ""
+		}
+		p.named[t.name] = t
+		switch t.kind {
+		case "record":
+			fields, _ := v["fields"].([]interface{})
+			for _, raw := range fields {
+				f, ok := raw.(map[string]interface{})
+				if !ok {
+					return nil, fmt.Errorf("invalid field of %s", t.name)
+				}
+				field := &avroField{aliases: stringList(f["aliases"])}
+				field.name, _ = f["name"].(string)
+				_, field.hasDefault = f["default"]
+				var err error
+				if field.typ, err = p.parse(f["type"], namespace); err != nil {
+					return nil, fmt.Errorf("field %s of %s: %w", field.name, t.name, err)
+				}
+				t.fields = append(t.fields, field)
+			}
+		case "enum":
+			t.symbols = stringList(v["symbols"])
+			t.enumDefault, _ = v["default"].(string)
+		case "fixed":
+			size, _ := v["size"].(float64)
+			t.size = int(size)
+		}
+		return t, nil
+	case "array", "map":
+		key := "items"
+		if kind == "map" {
+			key = "values"
+		}
+		items, err := p.parse(v[key], namespace)
+		if err != nil {
+			return nil, err
+		}
+		return &avroType{kind: kind, items: items}, nil
+	}
+	// a primitive type, possibly with a logical type
+	return p.parse(kind, namespace)
+}
+
+func fullName(name, namespace string) string {
+	if strings.Contains(name, ".") || namespace == "" {
+		return name
+	}
+	return namespace + "." + name
+}
+
+func shortName(name string) string {
+	return name[strings.LastIndex(name, ".")+1:]
+}
+
+func stringList(raw interface{}) []string {
+	list, _ := raw.([]interface{})
+	var out []string
+	for _, v := range list {
+		if s, ok := v.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+func (t *avroType) String() string {
+	if t.name != "" {
+		return shortName(t.name)
+	}
+	switch t.kind {
+	case "array":
+		return "array<" + t.items.String() + ">"
+	case "map":
+		return "map<" + t.items.String() + ">"
+	case "union":
+		names := make([]string, 0, len(t.branches))
+		for _, branch := range t.branches {
+			names = append(names, branch.String())
+		}
+		return "[" + strings.Join(names, ", ") + "]"
+	}
+	return t.kind
+}
+
+// avroChecker checks whether a reader schema can read the data written with
+// a writer schema.
+type avroChecker struct {
+	forward           bool
+	seen              map[[2]*avroType]bool
+	incompatibilities []SchemaIncompatibility
+}
+
+func (c *avroChecker) report(path, format string, args ...interface{}) {
+	c.incompatibilities = append(c.incompatibilities, SchemaIncompatibility{
+		Forward: c.forward,
+		Path:    path,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// change returns the existing and the new values of a change between the
+// reader and the writer schemas.
+func (c *avroChecker) change(reader, writer interface{}) (interface{}, interface{}) {
+	if c.forward {
+		return reader, writer
+	}
+	return writer, reader
+}
+
+// compatible reports whether reader can read writer, without reporting. The
+// records being checked are assumed compatible, which ends the recursion of
+// recursive types.
+func (c *avroChecker) compatible(reader, writer *avroType) bool {
+	probe := &avroChecker{forward: c.forward, seen: make(map[[2]*avroType]bool, len(c.seen))}
+	for pair := range c.seen {
+		probe.seen[pair] = true
+	}
+	probe.check(reader, writer, "")
+	return len(probe.incompatibilities) == 0
+}
+
+func (c *avroChecker) check(reader, writer *avroType, path string) {
+	if writer.kind == "union" {
+		for _, branch := range writer.branches {
+			if reader.kind == "union" {
+				if !c.readableByUnion(reader, branch) {
+					old, updated := c.change(reader, writer)
+					c.report(path, "union changed from %s to %s", old, updated)
+					return
+				}
+				continue
+			}
+			if branch.kind == reader.kind {
+				c.check(reader, branch, path)
+			} else if !c.compatible(reader, branch) {
+				old, updated := c.change(reader, writer)
+				c.report(path, "type changed from %s to %s", old, updated)
+				return
+			}
+		}
+		return
+	}
+	if reader.kind == "union" {
+		if !c.readableByUnion(reader, writer) {
+			old, updated := c.change(reader, writer)
+			c.report(path, "type changed from %s to %s", old, updated)
+		}
+		return
+	}
+
+	if reader.kind != writer.kind {
+		for _, promoted := range avroPromotions[reader.kind] {
+			if promoted == writer.kind {
+				return
+			}
+		}
+		old, updated := c.change(reader, writer)
+		c.report(path, "type changed from %s to %s", old, updated)
+		return
+	}
+
+	switch reader.kind {
+	case "record":
+		if !c.sameName(reader, writer, path) {
+			return
+		}
+		pair := [2]*avroType{reader, writer}
+		if c.seen[pair] {
+			return
+		}
+		c.seen[pair] = true
+		c.checkFields(reader, writer, path)
+	case "enum":
+		if !c.sameName(reader, writer, path) || reader.enumDefault != "" {
+			return
+		}
+		for _, symbol := range writer.symbols {
+			if !containsString(reader.symbols, symbol) {
+				if c.forward {
+					c.report(path, "enum symbol %s was added", symbol)
+				} else {
+					c.report(path, "enum symbol %s was removed", symbol)
+				}
+			}
+		}
+	case "fixed":
+		if !c.sameName(reader, writer, path) {
+			return
+		}
+		if reader.size != writer.size {
+			old, updated := c.change(reader.size, writer.size)
+			c.report(path, "fixed size changed from %d to %d", old, updated)
+		}
+	case "array":
+		c.check(reader.items, writer.items, joinPath(path, "[]"))
+	case "map":
+		c.check(reader.items, writer.items, joinPath(path, "{}"))
+	}
+}
+
+func (c *avroChecker) readableByUnion(reader, writer *avroType) bool {
+	for _, branch := range reader.branches {
+		if c.compatible(branch, writer) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *avroChecker) sameName(reader, writer *avroType, path string) bool {
+	if shortName(reader.name) == shortName(writer.name) || containsString(reader.aliases, writer.name) ||
+		containsString(reader.aliases, shortName(writer.name)) {
+		return true
+	}
+	old, updated := c.change(shortName(reader.name), shortName(writer.name))
+	c.report(path, "%s name changed from %s to %s", reader.kind, old, updated)
+	return false
+}
+
+func (c *avroChecker) checkFields(reader, writer *avroType, path string) {
+	if path == "" {
+		path = shortName(reader.name)
+	}
+	for _, field := range reader.fields {
+		fieldPath := joinPath(path, field.name)
+		writerField := findField(writer, field)
+		if writerField == nil {
+			if !field.hasDefault {
+				if c.forward {
+					c.report(fieldPath, "field was removed, and has no default value in the existing schema")
+				} else {
+					c.report(fieldPath, "field was added without a default value")
+				}
+			}
+			continue
+		}
+		c.check(field.typ, writerField.typ, fieldPath)
+	}
+}
+
+// findField returns the writer field read by a reader field, by name or by
+// alias.
+func findField(writer *avroType, field *avroField) *avroField {
+	for _, f := range writer.fields {
+		if f.name == field.name {
+			return f
+		}
+	}
+	for _, f := range writer.fields {
+		if containsString(field.aliases, f.name) {
+			return f
+		}
+	}
+	return nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,191 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsaradmin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func avroSchemaInfo(definition string) SchemaInfo {
+	return SchemaInfo{Type: "AVRO", Schema: []byte(definition)}
+}
+
+func TestCheckSchemaCompatibility(t *testing.T) {
+	withName := `{"type":"record","name":"Order","fields":[{"name":"id","type":"long"},
+		{"name":"name","type":"string","default":""}]}`
+	withRequiredName := `{"type":"record","name":"Order","fields":[{"name":"id","type":"long"},
+		{"name":"name","type":"string"}]}`
+	withIntID := `{"type":"record","name":"Order","fields":[{"name":"id","type":"int"}]}`
+
+	for _, tc := range []struct {
+		name     string
+		existing string
+		schema   string
+		strategy SchemaCompatibilityStrategy
+		want     []SchemaIncompatibility
+	}{{
+		name:     "field added with a default",
+		existing: orderSchema,
+		schema:   withName,
+		strategy: Full,
+	}, {
+		name:     "field added without a default",
+		existing: orderSchema,
+		schema:   withRequiredName,
+		strategy: Full,
+		want:     []SchemaIncompatibility{{Path: "Order.name", Message: "field was added without a default value"}},
+	}, {
+		name:     "field added without a default read by the existing schema",
+		existing: orderSchema,
+		schema:   withRequiredName,
+		strategy: Forward,
+	}, {
+		name:     "field removed without a default",
+		existing: withRequiredName,
+		schema:   orderSchema,
+		strategy: Full,
+		want: []SchemaIncompatibility{{Forward: true, Path: "Order.name",
+			Message: "field was removed, and has no default value in the existing schema"}},
+	}, {
+		name:     "type promoted",
+		existing: withIntID,
+		schema:   orderSchema,
+		strategy: Backward,
+	}, {
+		name:     "type promoted read by the existing schema",
+		existing: withIntID,
+		schema:   orderSchema,
+		strategy: Full,
+		want:     []SchemaIncompatibility{{Forward: true, Path: "Order.id", Message: "type changed from int to long"}},
+	}, {
+		name: "nested field type changed",
+		existing: `{"type":"record","name":"Order","fields":[{"name":"lines","type":{"type":"array",
+			"items":{"type":"record","name":"Line","fields":[{"name":"price","type":"double"}]}}}]}`,
+		schema: `{"type":"record","name":"Order","fields":[{"name":"lines","type":{"type":"array",
+			"items":{"type":"record","name":"Line","fields":[{"name":"price","type":"string"}]}}}]}`,
+		strategy: Backward,
+		want: []SchemaIncompatibility{
+			{Path: "Order.lines[].price", Message: "type changed from double to string"},
+		},
+	}, {
+		name: "enum symbol removed",
+		existing: `{"type":"record","name":"Order","fields":[{"name":"state","type":{"type":"enum",
+			"name":"State","symbols":["OPEN","CLOSED"]}}]}`,
+		schema: `{"type":"record","name":"Order","fields":[{"name":"state","type":{"type":"enum",
+			"name":"State","symbols":["OPEN"]}}]}`,
+		strategy: Full,
+		want:     []SchemaIncompatibility{{Path: "Order.state", Message: "enum symbol CLOSED was removed"}},
+	}, {
+		name:     "null removed from a union",
+		existing: `{"type":"record","name":"Order","fields":[{"name":"note","type":["null","string"]}]}`,
+		schema:   `{"type":"record","name":"Order","fields":[{"name":"note","type":"string"}]}`,
+		strategy: Full,
+		want: []SchemaIncompatibility{
+			{Path: "Order.note", Message: "type changed from [null, string] to string"},
+		},
+	}, {
+		name:     "record renamed with an alias",
+		existing: orderSchema,
+		schema:   `{"type":"record","name":"Purchase","aliases":["Order"],"fields":[{"name":"id","type":"long"}]}`,
+		strategy: Backward,
+	}, {
+		name:     "recursive record",
+		existing: `{"type":"record","name":"Node","fields":[{"name":"next","type":["null","Node"]}]}`,
+		schema: `{"type":"record","name":"Node","fields":[{"name":"next","type":["null","Node"]},
+			{"name":"label","type":"string","default":""}]}`,
+		strategy: Full,
+	}, {
+		name:     "update disabled",
+		existing: orderSchema,
+		schema:   withName,
+		strategy: AutoUpdateDisabled,
+		want:     []SchemaIncompatibility{{Message: "schema updates are disabled"}},
+	}, {
+		name:     "update disabled with the same schema",
+		existing: orderSchema,
+		schema:   `{"type": "record", "name": "Order", "fields": [{"name": "id", "type": "long"}]}`,
+		strategy: AutoUpdateDisabled,
+	}, {
+		name:     "always compatible",
+		existing: orderSchema,
+		schema:   `{"type":"record","name":"Other","fields":[]}`,
+		strategy: AlwaysCompatible,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := CheckSchemaCompatibility([]SchemaInfo{avroSchemaInfo(tc.existing)},
+				avroSchemaInfo(tc.schema), tc.strategy)
+			require.NoError(t, err)
+			assert.Equal(t, len(tc.want) == 0, result.Compatible)
+			assert.Equal(t, tc.want, result.Incompatibilities)
+		})
+	}
+}
+
+func TestCheckSchemaCompatibilityTransitive(t *testing.T) {
+	existing := []SchemaInfo{
+		avroSchemaInfo(orderSchema),
+		avroSchemaInfo(`{"type":"record","name":"Order","fields":[{"name":"id","type":"long"},
+			{"name":"name","type":"string","default":""}]}`),
+	}
+	schema := avroSchemaInfo(`{"type":"record","name":"Order","fields":[{"name":"id","type":"long"},
+		{"name":"name","type":"string"}]}`)
+
+	result, err := CheckSchemaCompatibility(existing, schema, Backward)
+	require.NoError(t, err)
+	assert.True(t, result.Compatible)
+
+	result, err = CheckSchemaCompatibility(existing, schema, BackwardTransitive)
+	require.NoError(t, err)
+	assert.False(t, result.Compatible)
+	require.Len(t, result.Incompatibilities, 1)
+	assert.Equal(t, "version 0: Order.name: field was added without a default value",
+		result.Incompatibilities[0].String())
+}
+
+func TestCheckSchemaCompatibilitySchemaTypes(t *testing.T) {
+	existing := []SchemaInfo{{Type: "AVRO", Schema: []byte(orderSchema)}}
+
+	result, err := CheckSchemaCompatibility(existing, SchemaInfo{Type: "JSON", Schema: []byte(orderSchema)}, Backward)
+	require.NoError(t, err)
+	assert.Equal(t, []SchemaIncompatibility{{Message: "schema type changed from AVRO to JSON"}},
+		result.Incompatibilities)
+
+	result, err = CheckSchemaCompatibility([]SchemaInfo{{Type: "STRING"}}, SchemaInfo{Type: "STRING"}, Full)
+	require.NoError(t, err)
+	assert.True(t, result.Compatible)
+
+	keyValue := func(value string) SchemaInfo {
+		return SchemaInfo{Type: "KEY_VALUE", KeyValue: &KeyValueSchemaInfo{
+			Key:   &SchemaInfo{Type: "STRING"},
+			Value: &SchemaInfo{Type: "JSON", Schema: []byte(value)},
+		}}
+	}
+	result, err = CheckSchemaCompatibility([]SchemaInfo{keyValue(orderSchema)},
+		keyValue(`{"type":"record","name":"Order","fields":[{"name":"id","type":"string"}]}`), Backward)
+	require.NoError(t, err)
+	assert.Equal(t, []SchemaIncompatibility{{Path: "value.Order.id", Message: "type changed from long to string"}},
+		result.Incompatibilities)
+
+	_, err = CheckSchemaCompatibility([]SchemaInfo{{Type: "JSON", Schema: []byte(orderSchema)}},
+		SchemaInfo{Type: "JSON", Schema: []byte(`{"$schema":"http://json-schema.org/draft-07/schema#"}`)}, Full)
+	assert.Error(t, err)
+
+	_, err = CheckSchemaCompatibility(existing, existing[0], "Sideways")
+	assert.Error(t, err)
+}
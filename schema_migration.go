@@ -0,0 +1,228 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsaradmin
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// schemaHistory is the export file of the schema versions of a topic,
+// oldest first.
+type schemaHistory struct {
+	Topic    string              `json:"topic"`
+	Versions []GetSchemaResponse `json:"versions"`
+}
+
+// ExportSchemas exports the schema history of every topic of the given
+// namespaces, such as public/default, to dir, and returns the exported
+// topics. The topics without a schema are skipped, and the partitions of a
+// partitioned topic share the schema of the topic.
+//
+// The history of a topic is written, with the type, data, properties and
+// timestamp of each version, to the JSON file
+// dir/<tenant>/<namespace>/<domain>/<topic>.json, which ImportSchemas reads.
+func ExportSchemas(client Client, dir string, namespaces ...string) ([]string, error) {
+	var exported []string
+	for _, namespace := range namespaces {
+		ns, err := GetNamespaceName(namespace)
+		if err != nil {
+			return exported, err
+		}
+		partitioned, nonPartitioned, err := client.Topics().List(*ns)
+		if err != nil {
+			return exported, fmt.Errorf("list topics of %s: %w", namespace, err)
+		}
+
+		topics := make(map[string]bool)
+		for _, topic := range append(partitioned, nonPartitioned...) {
+			topics[schemaTopic(topic)] = true
+		}
+		names := make([]string, 0, len(topics))
+		for topic := range topics {
+			names = append(names, topic)
+		}
+		sort.Strings(names)
+
+		for _, topic := range names {
+			ok, err := exportSchemaHistory(client.Schemas(), dir, topic)
+			if err != nil {
+				return exported, fmt.Errorf("export schemas of %s: %w", topic, err)
+			}
+			if ok {
+				exported = append(exported, topic)
+			}
+		}
+	}
+	return exported, nil
+}
+
+// exportSchemaHistory writes the schema history of a topic, and reports
+// whether the topic has a schema.
+func exportSchemaHistory(schemas Schema, dir, topic string) (bool, error) {
+	topicName, err := GetTopicName(topic)
+	if err != nil {
+		return false, err
+	}
+	versions, err := schemas.GetAllSchemas(topic)
+	if IsNotFound(err) || (err == nil && len(versions) == 0) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	history := schemaHistory{Topic: topicName.String(), Versions: make([]GetSchemaResponse, 0, len(versions))}
+	for _, version := range versions {
+		history.Versions = append(history.Versions, GetSchemaResponse{
+			Version:    version.Version,
+			Type:       version.SchemaInfo.Type,
+			Timestamp:  version.SchemaInfo.Timestamp,
+			Data:       string(version.SchemaInfo.Schema),
+			Properties: version.SchemaInfo.Properties,
+		})
+	}
+	data, err := json.MarshalIndent(history, "", "  ")
+	if err != nil {
+		return false, err
+	}
+
+	path := filepath.Join(dir, topicName.GetTenant(), topicName.GetNamespace(), string(topicName.GetDomain()),
+		url.PathEscape(topicName.GetLocalName())+".json")
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return false, err
+	}
+	return true, os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// ImportSchemas replays the schema histories exported by ExportSchemas from
+// dir into the topics of the same names, and returns the imported topics.
+// The namespaces must exist.
+//
+// The versions are created oldest first, and each must be created with its
+// exported version number, so that the schema versions carried by the
+// existing messages keep resolving to the same schemas. The versions a topic
+// already has must match the exported ones, which makes an interrupted
+// import safe to run again. The timestamps of the created versions are set
+// by the broker.
+//
+// The broker numbers the versions it creates from 0 without gaps, and does
+// not create a version for a schema it already has. Before creating any
+// version of a topic, ImportSchemas therefore refuses a history with gaps,
+// such as one whose versions were deleted, or one returning to an earlier
+// schema, and a topic that already has more versions than the history. Each
+// version is then checked with Schema.TestCompatibility right before it is
+// created, under the compatibility strategy the broker applies to the topic,
+// and the import of the topic stops at the first incompatible version.
+func ImportSchemas(client Client, dir string) ([]string, error) {
+	var files []string
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err == nil && !d.IsDir() && strings.HasSuffix(path, ".json") {
+			files = append(files, path)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var imported []string
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return imported, err
+		}
+		var history schemaHistory
+		if err := json.Unmarshal(data, &history); err != nil {
+			return imported, fmt.Errorf("parse %s: %w", file, err)
+		}
+		if _, err := GetTopicName(history.Topic); err != nil {
+			return imported, fmt.Errorf("parse %s: %w", file, err)
+		}
+
+		if err := importSchemaHistory(client.Schemas(), &history); err != nil {
+			return imported, fmt.Errorf("import schemas of %s: %w", history.Topic, err)
+		}
+		imported = append(imported, history.Topic)
+	}
+	return imported, nil
+}
+
+func importSchemaHistory(schemas Schema, history *schemaHistory) error {
+	versions := history.Versions
+	sort.Slice(versions, func(i, j int) bool { return versions[i].Version < versions[j].Version })
+	infos := make([]SchemaInfo, len(versions))
+	for i, version := range versions {
+		if version.Version != int64(i) {
+			return fmt.Errorf("exported version %d should be version %d: the broker numbers the versions "+
+				"from 0 without gaps, so the history cannot be imported with the same version numbers",
+				version.Version, i)
+		}
+		infos[i] = SchemaInfo{Type: version.Type, Schema: []byte(version.Data), Properties: version.Properties}
+		for j := 0; j < i; j++ {
+			if sameSchema(&infos[j], &infos[i]) {
+				return fmt.Errorf("version %d has the schema of version %d: the broker would return version %d "+
+					"instead of creating it, so the history cannot be imported with the same version numbers",
+					i, j, j)
+			}
+		}
+	}
+
+	existing, err := schemas.GetAllSchemas(history.Topic)
+	if err != nil && !IsNotFound(err) {
+		return err
+	}
+	if len(existing) > len(versions) {
+		return fmt.Errorf("the topic has %d schema versions, more than the %d exported ones",
+			len(existing), len(versions))
+	}
+	for i, current := range existing {
+		if current.Version != versions[i].Version || !sameSchema(current.SchemaInfo, &infos[i]) {
+			return fmt.Errorf("existing schema version %d differs from exported version %d",
+				current.Version, versions[i].Version)
+		}
+	}
+
+	for i := len(existing); i < len(versions); i++ {
+		version := versions[i]
+		payload := PostSchemaPayload{SchemaType: version.Type, Schema: version.Data, Properties: version.Properties}
+		compatibility, err := schemas.TestCompatibility(history.Topic, payload)
+		if err != nil {
+			return fmt.Errorf("test the compatibility of version %d: %w", version.Version, err)
+		}
+		if !compatibility.IsCompatibility {
+			return fmt.Errorf("version %d is not compatible under the %s strategy of the topic",
+				version.Version, compatibility.SchemaCompatibilityStrategy)
+		}
+		if err := schemas.CreateSchemaByPayload(history.Topic, payload); err != nil {
+			return fmt.Errorf("create version %d: %w", version.Version, err)
+		}
+		created, err := schemas.GetVersionBySchema(history.Topic, payload)
+		if err != nil {
+			return fmt.Errorf("get version of version %d: %w", version.Version, err)
+		}
+		if created != version.Version {
+			return fmt.Errorf("version %d was created as version %d", version.Version, created)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,279 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsaradmin
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// NewAvroSchemaPayload returns the payload of an AVRO schema generated from
+// the Go struct type of v, which may be a pointer.
+//
+// A struct maps to a record named after its type, or after its field for an
+// anonymous struct, and its exported fields to the fields of the record,
+// named by their avro tag, or else their json tag, or else their Go name. A
+// field tagged "-" is skipped, and an embedded struct without a tag is
+// inlined. A pointer, or a pointer to a pointer, maps to a union with null,
+// defaulting to null, a slice or an array to an array, a map with string keys
+// to a map, []byte to bytes and time.Time to a long with the timestamp-millis
+// logical type. Channels, functions, interfaces and complex numbers are not
+// supported, and neither are uint and uint64, whose values may not fit in an
+// Avro long. The names of the records and the fields must be valid Avro
+// names, matching [A-Za-z_][A-Za-z0-9_]*, so that instantiated generic types
+// are not supported either.
+func NewAvroSchemaPayload(v interface{}) (PostSchemaPayload, error) {
+	return newStructSchemaPayload("AVRO", "avro", v)
+}
+
+// NewJSONSchemaPayload returns the payload of a JSON schema generated from
+// the Go struct type of v, which may be a pointer. Like the schemas of the
+// Pulsar clients, its definition is an Avro record, generated as by
+// NewAvroSchemaPayload but with the fields named by their json tag only, and
+// time.Time and []byte mapped to strings, as encoding/json encodes them.
+func NewJSONSchemaPayload(v interface{}) (PostSchemaPayload, error) {
+	return newStructSchemaPayload("JSON", "", v)
+}
+
+func newStructSchemaPayload(schemaType, tag string, v interface{}) (PostSchemaPayload, error) {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return PostSchemaPayload{}, fmt.Errorf("%s schema of %T: not a struct", schemaType, v)
+	}
+
+	g := &avroGenerator{tag: tag, defined: make(map[reflect.Type]string), names: make(map[string]reflect.Type)}
+	name := t.Name()
+	if name == "" {
+		name = "Record"
+	}
+	definition, err := g.schema(t, name)
+	if err != nil {
+		return PostSchemaPayload{}, fmt.Errorf("%s schema of %s: %w", schemaType, t, err)
+	}
+	data, err := json.Marshal(definition)
+	if err != nil {
+		return PostSchemaPayload{}, err
+	}
+	return PostSchemaPayload{SchemaType: schemaType, Schema: string(data), Properties: map[string]string{}}, nil
+}
+
+type avroRecordDefinition struct {
+	Type   string                `json:"type"`
+	Name   string                `json:"name"`
+	Fields []avroFieldDefinition `json:"fields"`
+}
+
+type avroFieldDefinition struct {
+	Name    string          `json:"name"`
+	Type    interface{}     `json:"type"`
+	Default json.RawMessage `json:"default,omitempty"`
+}
+
+type avroArrayDefinition struct {
+	Type  string      `json:"type"`
+	Items interface{} `json:"items"`
+}
+
+type avroMapDefinition struct {
+	Type   string      `json:"type"`
+	Values interface{} `json:"values"`
+}
+
+type avroLogicalDefinition struct {
+	Type        string `json:"type"`
+	LogicalType string `json:"logicalType"`
+}
+
+var (
+	timeType  = reflect.TypeOf(time.Time{})
+	bytesType = reflect.TypeOf([]byte(nil))
+
+	avroName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+)
+
+// avroGenerator generates the Avro definitions of Go types. A struct is
+// defined once, and then referenced by name.
+type avroGenerator struct {
+	// tag is the struct tag naming the fields before the json tag, if any.
+	tag     string
+	defined map[reflect.Type]string
+	names   map[string]reflect.Type
+}
+
+// schema returns the definition of t. name names t when it is an anonymous
+// struct.
+func (g *avroGenerator) schema(t reflect.Type, name string) (interface{}, error) {
+	switch t {
+	case timeType:
+		if g.tag == "" {
+			// encoding/json encodes it as an RFC 3339 string
+			return "string", nil
+		}
+		return avroLogicalDefinition{Type: "long", LogicalType: "timestamp-millis"}, nil
+	case bytesType:
+		if g.tag == "" {
+			// encoding/json encodes it as a base64 string
+			return "string", nil
+		}
+		return "bytes", nil
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return "boolean", nil
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		return "int", nil
+	case reflect.Int, reflect.Int64, reflect.Uint32:
+		return "long", nil
+	case reflect.Float32:
+		return "float", nil
+	case reflect.Float64:
+		return "double", nil
+	case reflect.String:
+		return "string", nil
+	case reflect.Ptr:
+		// Avro does not allow a union in a union, so that all the levels of
+		// a pointer to a pointer share the same null
+		elem := t.Elem()
+		for elem.Kind() == reflect.Ptr {
+			elem = elem.Elem()
+		}
+		definition, err := g.schema(elem, name)
+		if err != nil {
+			return nil, err
+		}
+		return []interface{}{"null", definition}, nil
+	case reflect.Slice, reflect.Array:
+		items, err := g.schema(t.Elem(), name+"Item")
+		if err != nil {
+			return nil, err
+		}
+		return avroArrayDefinition{Type: "array", Items: items}, nil
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("map key %s is not a string", t.Key())
+		}
+		values, err := g.schema(t.Elem(), name+"Value")
+		if err != nil {
+			return nil, err
+		}
+		return avroMapDefinition{Type: "map", Values: values}, nil
+	case reflect.Struct:
+		return g.record(t, name)
+	case reflect.Uint, reflect.Uint64:
+		return nil, fmt.Errorf("unsupported type %s, whose values may not fit in an Avro long", t)
+	}
+	return nil, fmt.Errorf("unsupported type %s", t)
+}
+
+func (g *avroGenerator) record(t reflect.Type, name string) (interface{}, error) {
+	if defined, ok := g.defined[t]; ok {
+		return defined, nil
+	}
+	if t.Name() != "" {
+		name = t.Name()
+	}
+	if !avroName.MatchString(name) {
+		return nil, fmt.Errorf("record name %q of %s is not a valid Avro name", name, t)
+	}
+	if other, ok := g.names[name]; ok {
+		return nil, fmt.Errorf("types %s and %s are both named %s", other, t, name)
+	}
+	g.defined[t] = name
+	g.names[name] = t
+
+	record := avroRecordDefinition{Type: "record", Name: name, Fields: []avroFieldDefinition{}}
+	if err := g.addFields(&record, t); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+func (g *avroGenerator) addFields(record *avroRecordDefinition, t reflect.Type) error {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, named, skip := g.fieldName(field)
+		if skip {
+			continue
+		}
+		if field.Anonymous && !named {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				if err := g.addFields(record, embedded); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+		if !avroName.MatchString(name) {
+			return fmt.Errorf("field %s: name %q is not a valid Avro name", field.Name, name)
+		}
+
+		definition, err := g.schema(field.Type, record.Name+field.Name)
+		if err != nil {
+			return fmt.Errorf("field %s: %w", field.Name, err)
+		}
+		for _, other := range record.Fields {
+			if other.Name == name {
+				return fmt.Errorf("duplicate field %s", name)
+			}
+		}
+		f := avroFieldDefinition{Name: name, Type: definition}
+		if field.Type.Kind() == reflect.Ptr {
+			f.Default = json.RawMessage("null")
+		}
+		record.Fields = append(record.Fields, f)
+	}
+	return nil
+}
+
+// fieldName returns the name of a field, whether it was set by a tag, and
+// whether the field is skipped.
+func (g *avroGenerator) fieldName(field reflect.StructField) (name string, named, skip bool) {
+	for _, key := range []string{g.tag, "json"} {
+		if key == "" {
+			continue
+		}
+		tag, ok := field.Tag.Lookup(key)
+		if !ok {
+			continue
+		}
+		name, _, _ = strings.Cut(tag, ",")
+		if name == "-" {
+			return "", false, true
+		}
+		if name != "" {
+			return name, true, false
+		}
+	}
+	if field.PkgPath != "" && !field.Anonymous {
+		return "", false, true
+	}
+	return field.Name, false, false
+}
@@ -0,0 +1,141 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsaradmin
+
+import (
+	"testing"
+	"time"
+
+	"github.com/linkedin/goavro/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type schemaAudit struct {
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type schemaLine struct {
+	SKU   string  `json:"sku" avro:"product"`
+	Price float64 `json:"price"`
+}
+
+type schemaOrder struct {
+	schemaAudit
+	ID       int64             `json:"id"`
+	Note     *string           `json:"note,omitempty"`
+	Lines    []schemaLine      `json:"lines"`
+	Labels   map[string]string `json:"labels"`
+	Payload  []byte            `json:"payload"`
+	Next     *schemaOrder      `json:"next"`
+	Shipping struct {
+		City string
+	} `json:"shipping"`
+	Internal string `json:"-"`
+}
+
+func TestNewAvroSchemaPayload(t *testing.T) {
+	payload, err := NewAvroSchemaPayload(&schemaOrder{})
+	require.NoError(t, err)
+	assert.Equal(t, "AVRO", payload.SchemaType)
+	assert.Equal(t, map[string]string{}, payload.Properties)
+	assert.JSONEq(t, `{"type":"record","name":"schemaOrder","fields":[
+		{"name":"createdAt","type":{"type":"long","logicalType":"timestamp-millis"}},
+		{"name":"id","type":"long"},
+		{"name":"note","type":["null","string"],"default":null},
+		{"name":"lines","type":{"type":"array","items":{"type":"record","name":"schemaLine","fields":[
+			{"name":"product","type":"string"},{"name":"price","type":"double"}]}}},
+		{"name":"labels","type":{"type":"map","values":"string"}},
+		{"name":"payload","type":"bytes"},
+		{"name":"next","type":["null","schemaOrder"],"default":null},
+		{"name":"shipping","type":{"type":"record","name":"schemaOrderShipping","fields":[
+			{"name":"City","type":"string"}]}}]}`, payload.Schema)
+
+	_, err = goavro.NewCodec(payload.Schema)
+	require.NoError(t, err)
+
+	info := SchemaInfo{Type: payload.SchemaType, Schema: []byte(payload.Schema)}
+	result, err := CheckSchemaCompatibility([]SchemaInfo{info}, info, Full)
+	require.NoError(t, err)
+	assert.True(t, result.Compatible)
+}
+
+func TestNewAvroSchemaPayloadNestedPointer(t *testing.T) {
+	payload, err := NewAvroSchemaPayload(struct {
+		Count **int32 `json:"count"`
+	}{})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type":"record","name":"Record","fields":[
+		{"name":"count","type":["null","int"],"default":null}]}`, payload.Schema)
+
+	_, err = goavro.NewCodec(payload.Schema)
+	require.NoError(t, err)
+}
+
+func TestNewJSONSchemaPayload(t *testing.T) {
+	payload, err := NewJSONSchemaPayload(schemaLine{})
+	require.NoError(t, err)
+	assert.Equal(t, "JSON", payload.SchemaType)
+	assert.JSONEq(t, `{"type":"record","name":"schemaLine","fields":[
+		{"name":"sku","type":"string"},{"name":"price","type":"double"}]}`, payload.Schema)
+}
+
+func TestNewJSONSchemaPayloadStrings(t *testing.T) {
+	payload, err := NewJSONSchemaPayload(schemaOrder{})
+	require.NoError(t, err)
+	assert.Contains(t, payload.Schema, `{"name":"createdAt","type":"string"}`)
+	assert.Contains(t, payload.Schema, `{"name":"payload","type":"string"}`)
+}
+
+type schemaBox[T any] struct {
+	Value T
+}
+
+func TestNewAvroSchemaPayloadErrors(t *testing.T) {
+	_, err := NewAvroSchemaPayload("order")
+	assert.Error(t, err)
+
+	_, err = NewAvroSchemaPayload(struct{ Counts map[int]int }{})
+	assert.Error(t, err)
+
+	_, err = NewAvroSchemaPayload(struct{ Value interface{} }{})
+	assert.Error(t, err)
+
+	// the values of uint and uint64 overflow an Avro long
+	_, err = NewAvroSchemaPayload(struct{ Offset uint64 }{})
+	assert.ErrorContains(t, err, "may not fit in an Avro long")
+	_, err = NewAvroSchemaPayload(struct{ Offset *uint }{})
+	assert.ErrorContains(t, err, "may not fit in an Avro long")
+	_, err = NewAvroSchemaPayload(struct{ Offset uint32 }{})
+	assert.NoError(t, err)
+
+	_, err = NewAvroSchemaPayload(struct {
+		A string `avro:"id"`
+		B string `json:"id"`
+	}{})
+	assert.Error(t, err)
+
+	_, err = NewAvroSchemaPayload(schemaBox[int]{})
+	assert.ErrorContains(t, err, "is not a valid Avro name")
+	_, err = NewJSONSchemaPayload(struct {
+		Name string `json:"first-name"`
+	}{})
+	assert.ErrorContains(t, err, "is not a valid Avro name")
+	_, err = NewAvroSchemaPayload(struct {
+		Size int32 `avro:"2x"`
+	}{})
+	assert.ErrorContains(t, err, "is not a valid Avro name")
+}
@@ -15,11 +15,63 @@
 
 package pulsaradmin
 
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
 type SchemaInfo struct {
 	Name       string            `json:"name"`
 	Schema     []byte            `json:"schema"`
 	Type       string            `json:"type"`
 	Properties map[string]string `json:"properties"`
+	// Timestamp is when the schema version was created, in milliseconds since
+	// the epoch.
+	Timestamp int64 `json:"timestamp,omitempty"`
+	// KeyValue holds the key and value schemas of a KEY_VALUE schema.
+	KeyValue *KeyValueSchemaInfo `json:"keyValue,omitempty"`
+}
+
+// KeyValueEncodingType is how the key of a KEY_VALUE schema is encoded.
+type KeyValueEncodingType string
+
+const (
+	// KeyValueEncodingInline encodes the key and the value in the payload.
+	KeyValueEncodingInline KeyValueEncodingType = "INLINE"
+	// KeyValueEncodingSeparated encodes the key in the message key, and the
+	// value in the payload.
+	KeyValueEncodingSeparated KeyValueEncodingType = "SEPARATED"
+)
+
+// KeyValueSchemaInfo is the key and value schemas of a KEY_VALUE schema.
+type KeyValueSchemaInfo struct {
+	Key      *SchemaInfo          `json:"key"`
+	Value    *SchemaInfo          `json:"value"`
+	Encoding KeyValueEncodingType `json:"encoding"`
+}
+
+// The properties of a KEY_VALUE schema describing its key and value schemas.
+const (
+	keyValueEncodingTypeProperty = "kv.encoding.type"
+	keySchemaPrefix              = "key.schema."
+	valueSchemaPrefix            = "value.schema."
+)
+
+// primitiveSchemaTypes are the schema types without a schema definition.
+var primitiveSchemaTypes = map[string]bool{
+	"NONE": true, "STRING": true, "BYTES": true, "BOOLEAN": true, "INT8": true, "INT16": true, "INT32": true,
+	"INT64": true, "FLOAT": true, "DOUBLE": true, "DATE": true, "TIME": true, "TIMESTAMP": true, "INSTANT": true,
+	"LOCAL_DATE": true, "LOCAL_TIME": true, "LOCAL_DATE_TIME": true,
+}
+
+// keyValueSchemaData is the schema data of a KEY_VALUE schema in the REST
+// API, with the definitions of the key and value schemas, or an empty string
+// for a primitive schema.
+type keyValueSchemaData struct {
+	Key   json.RawMessage `json:"key"`
+	Value json.RawMessage `json:"value"`
 }
 
 type SchemaInfoWithVersion struct {
@@ -42,19 +94,36 @@ type GetSchemaResponse struct {
 	Properties map[string]string `json:"properties"`
 }
 
+// GetAllVersionsSchemaResponse is the response listing all the schema
+// versions of a topic.
+type GetAllVersionsSchemaResponse struct {
+	GetSchemaResponses []GetSchemaResponse `json:"getSchemaResponses"`
+}
+
+// LongSchemaVersion is the response with the version of a schema.
+type LongSchemaVersion struct {
+	Version int64 `json:"version"`
+}
+
+// IsCompatibilityResponse is the result of a schema compatibility check.
+type IsCompatibilityResponse struct {
+	IsCompatibility bool `json:"compatibility"`
+	// SchemaCompatibilityStrategy is the strategy the schema was checked
+	// with, as named by the broker, such as FULL or BACKWARD_TRANSITIVE.
+	SchemaCompatibilityStrategy string `json:"schemaCompatibilityStrategy"`
+}
+
 func ConvertGetSchemaResponseToSchemaInfo(tn *TopicName, response GetSchemaResponse) *SchemaInfo {
 	info := new(SchemaInfo)
-	schema := make([]byte, 0, 10)
-	if response.Type == "KEY_VALUE" {
-		// TODO: impl logic
-	} else {
-		schema = []byte(response.Data)
-	}
-
-	info.Schema = schema
+	info.Schema = []byte(response.Data)
 	info.Type = response.Type
 	info.Properties = response.Properties
+	info.Timestamp = response.Timestamp
 	info.Name = tn.GetLocalName()
+	if response.Type == "KEY_VALUE" {
+		// A malformed KEY_VALUE schema is left undecoded.
+		info.KeyValue, _ = decodeKeyValueSchema(response.Data, response.Properties)
+	}
 
 	return info
 }
@@ -65,3 +134,116 @@ func ConvertGetSchemaResponseToSchemaInfoWithVersion(tn *TopicName, response Get
 	info.Version = response.Version
 	return info
 }
+
+// ConvertSchemaInfoToPostSchemaPayload returns the payload creating a schema.
+// The schema data of a KEY_VALUE schema is built from its KeyValue field
+// when it is set.
+func ConvertSchemaInfoToPostSchemaPayload(info SchemaInfo) (PostSchemaPayload, error) {
+	payload := PostSchemaPayload{
+		SchemaType: info.Type,
+		Schema:     string(info.Schema),
+		Properties: info.Properties,
+	}
+	if info.Type != "KEY_VALUE" || info.KeyValue == nil {
+		return payload, nil
+	}
+
+	kv := info.KeyValue
+	if kv.Key == nil || kv.Value == nil {
+		return payload, fmt.Errorf("KEY_VALUE schema %s needs a key and a value schema", info.Name)
+	}
+	var data keyValueSchemaData
+	var err error
+	if data.Key, err = keyValueSchemaDefinition(kv.Key); err != nil {
+		return payload, fmt.Errorf("key schema of %s: %w", info.Name, err)
+	}
+	if data.Value, err = keyValueSchemaDefinition(kv.Value); err != nil {
+		return payload, fmt.Errorf("value schema of %s: %w", info.Name, err)
+	}
+	schema, err := json.Marshal(data)
+	if err != nil {
+		return payload, err
+	}
+	payload.Schema = string(schema)
+
+	payload.Properties = make(map[string]string, len(info.Properties)+7)
+	for k, v := range info.Properties {
+		payload.Properties[k] = v
+	}
+	encoding := kv.Encoding
+	if encoding == "" {
+		encoding = KeyValueEncodingInline
+	}
+	payload.Properties[keyValueEncodingTypeProperty] = string(encoding)
+	if err := setKeyValueSchemaProperties(payload.Properties, keySchemaPrefix, kv.Key); err != nil {
+		return payload, err
+	}
+	if err := setKeyValueSchemaProperties(payload.Properties, valueSchemaPrefix, kv.Value); err != nil {
+		return payload, err
+	}
+	return payload, nil
+}
+
+func decodeKeyValueSchema(data string, properties map[string]string) (*KeyValueSchemaInfo, error) {
+	var kvData keyValueSchemaData
+	if err := json.Unmarshal([]byte(data), &kvData); err != nil {
+		return nil, fmt.Errorf("invalid KEY_VALUE schema data: %w", err)
+	}
+	kv := &KeyValueSchemaInfo{Encoding: KeyValueEncodingType(strings.ToUpper(properties[keyValueEncodingTypeProperty]))}
+	if kv.Encoding == "" {
+		kv.Encoding = KeyValueEncodingInline
+	}
+	var err error
+	if kv.Key, err = keyValueSchemaInfo(properties, keySchemaPrefix, kvData.Key); err != nil {
+		return nil, err
+	}
+	if kv.Value, err = keyValueSchemaInfo(properties, valueSchemaPrefix, kvData.Value); err != nil {
+		return nil, err
+	}
+	return kv, nil
+}
+
+// keyValueSchemaInfo returns the key or value schema of a KEY_VALUE schema,
+// described by the properties with the given prefix.
+func keyValueSchemaInfo(properties map[string]string, prefix string, definition json.RawMessage) (*SchemaInfo, error) {
+	info := &SchemaInfo{
+		Name: properties[prefix+"name"],
+		Type: properties[prefix+"type"],
+	}
+	if definition != nil && !bytes.Equal(definition, []byte(`""`)) && !bytes.Equal(definition, []byte("null")) {
+		info.Schema = definition
+	}
+	if p := properties[prefix+"properties"]; p != "" {
+		if err := json.Unmarshal([]byte(p), &info.Properties); err != nil {
+			return nil, fmt.Errorf("invalid %sproperties: %w", prefix, err)
+		}
+	}
+	return info, nil
+}
+
+// keyValueSchemaDefinition returns the definition of a key or value schema
+// in the schema data of a KEY_VALUE schema.
+func keyValueSchemaDefinition(info *SchemaInfo) (json.RawMessage, error) {
+	if primitiveSchemaTypes[strings.ToUpper(info.Type)] || len(info.Schema) == 0 {
+		return json.RawMessage(`""`), nil
+	}
+	if !json.Valid(info.Schema) {
+		return nil, fmt.Errorf("%s schema definition is not valid JSON", info.Type)
+	}
+	return json.RawMessage(info.Schema), nil
+}
+
+func setKeyValueSchemaProperties(properties map[string]string, prefix string, info *SchemaInfo) error {
+	properties[prefix+"name"] = info.Name
+	properties[prefix+"type"] = info.Type
+	schemaProperties := info.Properties
+	if schemaProperties == nil {
+		schemaProperties = map[string]string{}
+	}
+	data, err := json.Marshal(schemaProperties)
+	if err != nil {
+		return err
+	}
+	properties[prefix+"properties"] = string(data)
+	return nil
+}
@@ -0,0 +1,97 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsaradmin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const orderSchema = `{"type":"record","name":"Order","fields":[{"name":"id","type":"long"}]}`
+
+func TestConvertGetSchemaResponseToSchemaInfoKeyValue(t *testing.T) {
+	topic, err := GetTopicName("persistent://public/default/orders")
+	require.NoError(t, err)
+
+	info := ConvertGetSchemaResponseToSchemaInfo(topic, GetSchemaResponse{
+		Type: "KEY_VALUE",
+		Data: `{"key":"","value":` + orderSchema + `}`,
+		Properties: map[string]string{
+			"kv.encoding.type":        "SEPARATED",
+			"key.schema.name":         "String",
+			"key.schema.type":         "STRING",
+			"key.schema.properties":   "{}",
+			"value.schema.name":       "Order",
+			"value.schema.type":       "AVRO",
+			"value.schema.properties": `{"__alwaysAllowNull":"true"}`,
+		},
+	})
+	assert.Equal(t, "KEY_VALUE", info.Type)
+	require.NotNil(t, info.KeyValue)
+	assert.Equal(t, KeyValueEncodingSeparated, info.KeyValue.Encoding)
+	assert.Equal(t, &SchemaInfo{Name: "String", Type: "STRING", Properties: map[string]string{}}, info.KeyValue.Key)
+	assert.Equal(t, &SchemaInfo{Name: "Order", Type: "AVRO", Schema: []byte(orderSchema),
+		Properties: map[string]string{"__alwaysAllowNull": "true"}}, info.KeyValue.Value)
+
+	invalid := ConvertGetSchemaResponseToSchemaInfo(topic, GetSchemaResponse{Type: "KEY_VALUE", Data: "invalid"})
+	assert.Nil(t, invalid.KeyValue)
+	assert.Equal(t, "invalid", string(invalid.Schema))
+}
+
+func TestConvertSchemaInfoToPostSchemaPayloadKeyValue(t *testing.T) {
+	info := SchemaInfo{
+		Name: "orders",
+		Type: "KEY_VALUE",
+		KeyValue: &KeyValueSchemaInfo{
+			Key:   &SchemaInfo{Name: "String", Type: "STRING"},
+			Value: &SchemaInfo{Name: "Order", Type: "JSON", Schema: []byte(orderSchema)},
+		},
+	}
+	payload, err := ConvertSchemaInfoToPostSchemaPayload(info)
+	require.NoError(t, err)
+	assert.Equal(t, "KEY_VALUE", payload.SchemaType)
+	assert.JSONEq(t, `{"key":"","value":`+orderSchema+`}`, payload.Schema)
+	assert.Equal(t, map[string]string{
+		"kv.encoding.type":        "INLINE",
+		"key.schema.name":         "String",
+		"key.schema.type":         "STRING",
+		"key.schema.properties":   "{}",
+		"value.schema.name":       "Order",
+		"value.schema.type":       "JSON",
+		"value.schema.properties": "{}",
+	}, payload.Properties)
+
+	topic, err := GetTopicName("persistent://public/default/orders")
+	require.NoError(t, err)
+	got := ConvertGetSchemaResponseToSchemaInfo(topic, GetSchemaResponse{
+		Type:       payload.SchemaType,
+		Data:       payload.Schema,
+		Properties: payload.Properties,
+	})
+	assert.Equal(t, KeyValueEncodingInline, got.KeyValue.Encoding)
+	assert.JSONEq(t, orderSchema, string(got.KeyValue.Value.Schema))
+	assert.Nil(t, got.KeyValue.Key.Schema)
+
+	info.KeyValue.Value.Schema = []byte("not json")
+	_, err = ConvertSchemaInfoToPostSchemaPayload(info)
+	assert.ErrorContains(t, err, "value schema of orders")
+
+	payload, err = ConvertSchemaInfoToPostSchemaPayload(SchemaInfo{Type: "JSON", Schema: []byte(orderSchema)})
+	require.NoError(t, err)
+	assert.Equal(t, PostSchemaPayload{SchemaType: "JSON", Schema: orderSchema}, payload)
+}
@@ -0,0 +1,290 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsaradmin
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/textproto"
+	"os"
+	"sync"
+
+	"github.com/streamnative/pulsar-admin-go/internal/rest"
+)
+
+// UploadOptions configures the upload of a package or connector read from
+// an io.Reader.
+//
+// The data is streamed as it is read. When the reader is an io.Seeker, such
+// as a file, it is read again from its initial offset when the request is
+// sent again, to follow a redirect or to retry it; otherwise the request is
+// not sent again, and fails on a redirect.
+type UploadOptions struct {
+	// Size is the number of bytes of the data, reported to Progress. When it
+	// is zero, the size of an io.Seeker is used.
+	Size int64
+	// Progress is called as the data is sent, with the number of bytes sent
+	// so far and the total size, or -1 when it is unknown. It restarts from
+	// zero when the request is sent again.
+	Progress func(sent, total int64)
+}
+
+var errUploadClosed = errors.New("upload body closed")
+
+// multipartField is a field of a multipart upload.
+type multipartField struct {
+	name        string
+	contentType string
+	data        []byte
+}
+
+// multipartUpload is a multipart body with fields and an optional file,
+// streamed through a pipe. The fields added before the file are sent before
+// it, and the others after it.
+type multipartUpload struct {
+	fields []multipartField
+	// fileIndex is the number of fields sent before the file.
+	fileIndex int
+	fileField string
+	fileName  string
+	file      io.Reader
+	options   UploadOptions
+	boundary  string
+	// checksumField, when set, returns a field sent after the file, from the
+	// SHA-256 checksum of the file.
+	checksumField func(checksum []byte) (multipartField, error)
+
+	// start is the initial offset of a seekable file.
+	start int64
+	total int64
+
+	mu   sync.Mutex
+	body *io.PipeReader
+	done chan struct{}
+}
+
+func newMultipartUpload(options *UploadOptions) *multipartUpload {
+	u := &multipartUpload{boundary: multipart.NewWriter(io.Discard).Boundary(), total: -1}
+	if options != nil {
+		u.options = *options
+	}
+	return u
+}
+
+// addJSONField adds a field with a JSON value.
+func (u *multipartUpload) addJSONField(name string, data []byte) {
+	u.fields = append(u.fields, multipartField{name: name, contentType: "application/json", data: data})
+}
+
+// addTextField adds a field with a plain text value.
+func (u *multipartUpload) addTextField(name, value string) {
+	u.fields = append(u.fields, multipartField{name: name, contentType: "text/plain", data: []byte(value)})
+}
+
+// setFile sets the file of the upload, sent in the field fileField with the
+// file name fileName.
+func (u *multipartUpload) setFile(fileField, fileName string, r io.Reader) error {
+	u.fileField, u.fileName, u.file = fileField, fileName, r
+	u.fileIndex = len(u.fields)
+	u.total = u.options.Size
+	seeker, ok := r.(io.Seeker)
+	if !ok {
+		if u.total <= 0 {
+			u.total = -1
+		}
+		return nil
+	}
+
+	var err error
+	if u.start, err = seeker.Seek(0, io.SeekCurrent); err != nil {
+		return err
+	}
+	if u.total <= 0 {
+		end, err := seeker.Seek(0, io.SeekEnd)
+		if err != nil {
+			return err
+		}
+		u.total = end - u.start
+		if _, err := seeker.Seek(u.start, io.SeekStart); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// replayable reports whether the body can be sent again.
+func (u *multipartUpload) replayable() bool {
+	if u.file == nil {
+		return true
+	}
+	_, ok := u.file.(io.Seeker)
+	return ok
+}
+
+func (u *multipartUpload) contentType() string {
+	return "multipart/form-data; boundary=" + u.boundary
+}
+
+// open returns the body, written by a goroutine as it is read. The body
+// previously opened is closed first, and its file rewound.
+func (u *multipartUpload) open() (io.ReadCloser, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if u.body != nil {
+		u.body.CloseWithError(errUploadClosed)
+		<-u.done
+		seeker, ok := u.file.(io.Seeker)
+		if !ok {
+			return nil, fmt.Errorf("upload of %s cannot be sent again", u.fileName)
+		}
+		if _, err := seeker.Seek(u.start, io.SeekStart); err != nil {
+			return nil, err
+		}
+	}
+
+	body, w := io.Pipe()
+	done := make(chan struct{})
+	u.body, u.done = body, done
+	go func() {
+		defer close(done)
+		w.CloseWithError(u.write(w))
+	}()
+	return body, nil
+}
+
+// close closes the body last opened, and waits until its goroutine stops
+// reading the file.
+func (u *multipartUpload) close() {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if u.body != nil {
+		u.body.CloseWithError(errUploadClosed)
+		<-u.done
+	}
+}
+
+// post posts the upload to endpoint.
+func (u *multipartUpload) post(c *rest.Client, endpoint string) error {
+	defer u.close()
+	return c.PostWithMultiPartStream(endpoint, u.open, u.replayable(), u.contentType())
+}
+
+// put puts the upload to endpoint.
+func (u *multipartUpload) put(c *rest.Client, endpoint string) error {
+	defer u.close()
+	return c.PutWithMultiPartStream(endpoint, u.open, u.replayable(), u.contentType())
+}
+
+func (u *multipartUpload) write(w io.Writer) error {
+	mw := multipart.NewWriter(w)
+	if err := mw.SetBoundary(u.boundary); err != nil {
+		return err
+	}
+	fields := u.fields
+	if u.file != nil {
+		fields = u.fields[u.fileIndex:]
+		for _, field := range u.fields[:u.fileIndex] {
+			if err := writeField(mw, field); err != nil {
+				return err
+			}
+		}
+
+		part, err := mw.CreateFormFile(u.fileField, u.fileName)
+		if err != nil {
+			return err
+		}
+		var r io.Reader = u.file
+		if u.options.Progress != nil {
+			u.options.Progress(0, u.total)
+			r = &progressReader{r: r, total: u.total, progress: u.options.Progress}
+		}
+		checksum := sha256.New()
+		if u.checksumField != nil {
+			r = io.TeeReader(r, checksum)
+		}
+		if _, err := io.Copy(part, r); err != nil {
+			return err
+		}
+		if u.checksumField != nil {
+			field, err := u.checksumField(checksum.Sum(nil))
+			if err != nil {
+				return err
+			}
+			if err := writeField(mw, field); err != nil {
+				return err
+			}
+		}
+	}
+	for _, field := range fields {
+		if err := writeField(mw, field); err != nil {
+			return err
+		}
+	}
+	return mw.Close()
+}
+
+// createFieldPart creates the part of a form field with a value of the given
+// content type.
+func createFieldPart(mw *multipart.Writer, name, contentType string) (io.Writer, error) {
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s" `, name))
+	h.Set("Content-Type", contentType)
+	return mw.CreatePart(h)
+}
+
+func writeField(mw *multipart.Writer, field multipartField) error {
+	part, err := createFieldPart(mw, field.name, field.contentType)
+	if err != nil {
+		return err
+	}
+	_, err = part.Write(field.data)
+	return err
+}
+
+// progressReader reports the bytes read from r.
+type progressReader struct {
+	r        io.Reader
+	sent     int64
+	total    int64
+	progress func(sent, total int64)
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	if n > 0 {
+		p.sent += int64(n)
+		p.progress(p.sent, p.total)
+	}
+	return n, err
+}
+
+// uploadFile opens the file at path, when set, and passes it to send, which
+// uploads it.
+func uploadFile(path string, send func(fileName string, r io.Reader) error) error {
+	if path == "" {
+		return send("", nil)
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return send(file.Name(), file)
+}
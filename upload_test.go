@@ -0,0 +1,164 @@
+// Copyright 2023 StreamNative, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pulsaradmin
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"mime"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// multipartRecorder records the parts of the multipart requests it receives.
+type multipartRecorder struct {
+	contentLength int64
+	parts         map[string]string
+	fileNames     map[string]string
+	// rawFileNames are the file names as sent, which Part.FileName reduces
+	// to their base name.
+	rawFileNames map[string]string
+	order        []string
+}
+
+func (m *multipartRecorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.contentLength = r.ContentLength
+	m.parts = make(map[string]string)
+	m.fileNames = make(map[string]string)
+	m.rawFileNames = make(map[string]string)
+	m.order = nil
+	reader, err := r.MultipartReader()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		data, _ := io.ReadAll(part)
+		m.parts[part.FormName()] = string(data)
+		m.fileNames[part.FormName()] = part.FileName()
+		if _, params, err := mime.ParseMediaType(part.Header.Get("Content-Disposition")); err == nil {
+			m.rawFileNames[part.FormName()] = params["filename"]
+		}
+		m.order = append(m.order, part.FormName())
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func TestUploadWithReaderStreamsWithProgress(t *testing.T) {
+	recorder := &multipartRecorder{}
+	server := httptest.NewServer(recorder)
+	defer server.Close()
+	client, err := NewClient(ClientConfig{WebServiceURL: server.URL})
+	require.NoError(t, err)
+
+	content := strings.Repeat("nar", 100000)
+	var sent, total int64
+	err = client.Packages().UploadWithReader("sink://public/default/nar@v1", "connector.nar",
+		io.MultiReader(strings.NewReader(content)), "desc", "dev", nil, &UploadOptions{
+			Size: int64(len(content)),
+			Progress: func(s, t int64) {
+				sent, total = s, t
+			},
+		})
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(-1), recorder.contentLength)
+	assert.Equal(t, content, recorder.parts["file"])
+	assert.Equal(t, "connector.nar", recorder.fileNames["file"])
+	checksum := sha256.Sum256([]byte(content))
+	assert.JSONEq(t, `{"description":"desc","contact":"dev","properties":{"sha256":"`+
+		hex.EncodeToString(checksum[:])+`"}}`, recorder.parts["metadata"])
+	assert.Equal(t, int64(len(content)), sent)
+	assert.Equal(t, int64(len(content)), total)
+}
+
+func TestCreateSinkWithReader(t *testing.T) {
+	recorder := &multipartRecorder{}
+	server := httptest.NewServer(recorder)
+	defer server.Close()
+	client, err := NewClient(ClientConfig{WebServiceURL: server.URL})
+	require.NoError(t, err)
+
+	config := &SinkConfig{Tenant: "public", Namespace: "default", Name: "sink"}
+	var total int64
+	err = client.Sinks().CreateSinkWithReader(config, "dir/sink.nar", bytes.NewReader([]byte("sink")),
+		&UploadOptions{Progress: func(_, t int64) { total = t }})
+	require.NoError(t, err)
+	assert.Equal(t, "sink", recorder.parts["data"])
+	assert.Equal(t, "sink.nar", recorder.fileNames["data"])
+	assert.Contains(t, recorder.parts["sinkConfig"], `"name":"sink"`)
+	assert.Equal(t, int64(4), total)
+
+	err = client.Sinks().CreateSinkWithReader(config, "sink.nar", nil, nil)
+	assert.Error(t, err)
+}
+
+func TestUploadWithReaderFollowsRedirect(t *testing.T) {
+	recorder := &multipartRecorder{}
+	owner := httptest.NewServer(recorder)
+	defer owner.Close()
+	broker := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, owner.URL+r.URL.RequestURI(), http.StatusTemporaryRedirect)
+	}))
+	defer broker.Close()
+	client, err := NewClient(ClientConfig{WebServiceURL: broker.URL})
+	require.NoError(t, err)
+
+	config := &FunctionConfig{Tenant: "public", Namespace: "default", Name: "fn"}
+	err = client.Functions().CreateFuncWithReader(config, "fn.jar", strings.NewReader("function"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "function", recorder.parts["data"])
+
+	// a reader that cannot seek cannot be sent again
+	err = client.Functions().CreateFuncWithReader(config, "fn.jar", io.MultiReader(strings.NewReader("function")),
+		nil)
+	assert.Error(t, err)
+}
+
+func TestFunctionsUploadSendsFileThenPath(t *testing.T) {
+	recorder := &multipartRecorder{}
+	server := httptest.NewServer(recorder)
+	defer server.Close()
+	client, err := NewClient(ClientConfig{WebServiceURL: server.URL})
+	require.NoError(t, err)
+
+	file := filepath.Join(t.TempDir(), "fn.jar")
+	require.NoError(t, os.WriteFile(file, []byte("jar"), 0o600))
+	require.NoError(t, client.Functions().Upload(file, "public/default/fn"))
+
+	// the file is sent first, named by the path it was opened with
+	assert.Equal(t, []string{"data", "path"}, recorder.order)
+	assert.Equal(t, file, recorder.rawFileNames["data"])
+	assert.Equal(t, "jar", recorder.parts["data"])
+	assert.Equal(t, "public/default/fn", recorder.parts["path"])
+}